@@ -0,0 +1,159 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// checkpoint records how far the events were exported. The next run queries the events again from Last minus the
+// overlap, to catch the events sharing the timestamp of the last exported one and the events Bitwarden ingested late,
+// and skips the ones already exported.
+type checkpoint struct {
+	// Last is the date of the newest exported event.
+	Last time.Time `json:"last"`
+	// Since is the date from which Exported holds the keys of every exported event.
+	Since time.Time `json:"since"`
+	// Exported holds the keys of the exported events since Since.
+	Exported []string `json:"exported,omitempty"`
+}
+
+// start returns the start of the date range of the next run. It doesn't go back further than Since, so that the events
+// whose keys were dropped aren't exported again when the overlap grows.
+func (cp checkpoint) start(overlap time.Duration) time.Time {
+	start := cp.Last.Add(-overlap)
+	if start.Before(cp.Since) {
+		return cp.Since
+	}
+
+	return start
+}
+
+// readCheckpoint returns the checkpoint of the previous run, found is false when there is no checkpoint yet.
+func readCheckpoint(path string) (cp checkpoint, found bool, err error) {
+	if path == "" {
+		return checkpoint{}, false, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return checkpoint{}, false, nil
+	}
+	if err != nil {
+		return checkpoint{}, false, fmt.Errorf("reading checkpoint: %w", err)
+	}
+
+	if err := json.Unmarshal(content, &cp); err != nil {
+		return checkpoint{}, false, fmt.Errorf("invalid checkpoint %s: %w", path, err)
+	}
+	if cp.Last.IsZero() {
+		return checkpoint{}, false, fmt.Errorf("invalid checkpoint %s: missing last", path)
+	}
+
+	return cp, true, nil
+}
+
+// writeCheckpoint replaces the checkpoint. The file is replaced atomically, so that an interrupted write doesn't
+// corrupt it.
+func writeCheckpoint(path string, cp checkpoint) error {
+	if path == "" {
+		return nil
+	}
+
+	content, err := json.Marshal(cp)
+	if err != nil {
+		return fmt.Errorf("writing checkpoint: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("writing checkpoint: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(append(content, '\n'))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("writing checkpoint: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("writing checkpoint: %w", err)
+	}
+
+	return nil
+}
+
+// eventKey identifies an event. Bitwarden events have no ID, so the key is a hash of all their fields, and identical
+// events are exported once.
+func eventKey(event bitwarden.Event) (string, error) {
+	content, err := json.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// selectEvents returns the events of a run which the previous run didn't export, in chronological order, and the
+// checkpoint of the run. events holds all the events since start, and overlap is how far before the newest event the
+// next run starts. The checkpoint is zero when no event was ever exported.
+func selectEvents(events []bitwarden.Event, previous checkpoint, start time.Time, overlap time.Duration) ([]bitwarden.Event, checkpoint, error) {
+	exported := make(map[string]bool, len(previous.Exported))
+	for _, key := range previous.Exported {
+		exported[key] = true
+	}
+
+	next := checkpoint{Last: previous.Last}
+	keys := make(map[string]time.Time, len(events))
+	var selected []bitwarden.Event
+	for _, event := range events {
+		key, err := eventKey(event)
+		if err != nil {
+			return nil, checkpoint{}, err
+		}
+		keys[key] = event.Date
+		if event.Date.After(next.Last) {
+			next.Last = event.Date
+		}
+
+		if exported[key] {
+			continue
+		}
+		exported[key] = true
+		selected = append(selected, event)
+	}
+	if next.Last.IsZero() {
+		return selected, checkpoint{}, nil
+	}
+
+	// Keep the keys of the events the next run queries again, which all belong to the date range of this run
+	next.Since = next.Last.Add(-overlap)
+	if next.Since.Before(start) {
+		next.Since = start
+	}
+	for key, date := range keys {
+		if !date.Before(next.Since) {
+			next.Exported = append(next.Exported, key)
+		}
+	}
+
+	slices.SortStableFunc(selected, func(a, b bitwarden.Event) int {
+		return a.Date.Compare(b.Date)
+	})
+	slices.Sort(next.Exported)
+
+	return selected, next, nil
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+func TestCheckpoint(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint")
+
+	if _, found, err := readCheckpoint(path); err != nil || found {
+		t.Fatalf("expected no checkpoint, got found=%v, err=%v", found, err)
+	}
+
+	cp := checkpoint{
+		Last:     time.Date(2024, 1, 2, 15, 4, 5, 123456789, time.UTC),
+		Since:    time.Date(2024, 1, 2, 14, 54, 5, 123456789, time.UTC),
+		Exported: []string{"key"},
+	}
+	if err := writeCheckpoint(path, cp); err != nil {
+		t.Fatal(err)
+	}
+
+	read, found, err := readCheckpoint(path)
+	if err != nil || !found || !read.Last.Equal(cp.Last) || !read.Since.Equal(cp.Since) || !slices.Equal(read.Exported, cp.Exported) {
+		t.Fatalf("expected checkpoint %v, got %v, found=%v, err=%v", cp, read, found, err)
+	}
+
+	entries, _ := os.ReadDir(filepath.Dir(path))
+	if len(entries) != 1 {
+		t.Errorf("expected only the checkpoint file, got %d entries", len(entries))
+	}
+}
+
+func TestCheckpointInvalid(t *testing.T) {
+	for name, content := range map[string]string{
+		"not json":     "yesterday",
+		"missing last": "{}",
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "checkpoint")
+			if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, _, err := readCheckpoint(path); err == nil {
+				t.Error("expected an error for an invalid checkpoint")
+			}
+		})
+	}
+}
+
+func TestSelectEvents(t *testing.T) {
+	base := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+	overlap := 10 * time.Minute
+	event := func(minutes int, memberID string) bitwarden.Event {
+		return bitwarden.Event{Type: bitwarden.EventUserLoggedIn, Date: base.Add(time.Duration(minutes) * time.Minute), MemberID: memberID}
+	}
+	memberIDs := func(events []bitwarden.Event) []string {
+		var ids []string
+		for _, e := range events {
+			ids = append(ids, e.MemberID)
+		}
+		return ids
+	}
+
+	// Without any event there is nothing to resume from
+	if _, cp, err := selectEvents(nil, checkpoint{}, base.Add(-time.Hour), overlap); err != nil || !cp.Last.IsZero() {
+		t.Fatalf("expected no checkpoint, got %v, err=%v", cp, err)
+	}
+
+	// The first run exports everything, and remembers the events within the overlap before the newest one
+	first := []bitwarden.Event{event(-1, "a"), event(-20, "b"), event(-5, "c")}
+	selected, cp, err := selectEvents(first, checkpoint{}, base.Add(-time.Hour), overlap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := memberIDs(selected); !slices.Equal(ids, []string{"b", "c", "a"}) {
+		t.Fatalf("expected all events in chronological order, got %v", ids)
+	}
+	if !cp.Last.Equal(base.Add(-time.Minute)) || !cp.Since.Equal(base.Add(-11*time.Minute)) || len(cp.Exported) != 2 {
+		t.Fatalf("expected the keys of the 2 events within the overlap, got %v", cp)
+	}
+	if start := cp.start(overlap); !start.Equal(cp.Since) {
+		t.Errorf("expected the next run to start at %s, got %s", cp.Since, start)
+	}
+
+	// A larger overlap doesn't go back before the remembered events
+	if start := cp.start(time.Hour); !start.Equal(cp.Since) {
+		t.Errorf("expected the next run to start at %s, got %s", cp.Since, start)
+	}
+
+	// The next run sees the same events again, along with one sharing the timestamp of the newest one and one
+	// ingested late
+	second := []bitwarden.Event{event(5, "d"), event(-1, "a"), event(-1, "e"), event(-5, "c"), event(-7, "f")}
+	selected, cp, err = selectEvents(second, cp, cp.start(overlap), overlap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := memberIDs(selected); !slices.Equal(ids, []string{"f", "e", "d"}) {
+		t.Errorf("expected only the new events, got %v", ids)
+	}
+	if !cp.Last.Equal(base.Add(5*time.Minute)) || len(cp.Exported) != 4 {
+		t.Errorf("expected the keys of the 4 events within the overlap, got %v", cp)
+	}
+
+	// A run without new events keeps the checkpoint
+	third := []bitwarden.Event{event(5, "d"), event(-1, "a"), event(-1, "e"), event(-5, "c")}
+	selected, next, err := selectEvents(third, cp, cp.start(overlap), overlap)
+	if err != nil || len(selected) != 0 || !next.Last.Equal(cp.Last) || !slices.Equal(next.Exported, cp.Exported) {
+		t.Errorf("expected the same checkpoint without new events, got %v, %v, err=%v", memberIDs(selected), next, err)
+	}
+}
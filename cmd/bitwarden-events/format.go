@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// formats maps the output formats to the function writing a single event.
+var formats = map[string]func(w io.Writer, event bitwarden.Event) error{
+	"ndjson": writeNDJSON,
+	"cef":    writeCEF,
+}
+
+// eventRecord is the JSON representation of an event, with the names of its type and device.
+type eventRecord struct {
+	Type         string `json:"type"`
+	TypeID       int64  `json:"type_id"`
+	Date         string `json:"date"`
+	ActingUserID string `json:"acting_user_id,omitempty"`
+	MemberID     string `json:"member_id,omitempty"`
+	GroupID      string `json:"group_id,omitempty"`
+	CollectionID string `json:"collection_id,omitempty"`
+	PolicyID     string `json:"policy_id,omitempty"`
+	ItemID       string `json:"item_id,omitempty"`
+	IPAddress    string `json:"ip_address,omitempty"`
+	Device       string `json:"device,omitempty"`
+}
+
+// writeNDJSON writes the event as a single line of JSON.
+func writeNDJSON(w io.Writer, event bitwarden.Event) error {
+	record := eventRecord{
+		Type:         event.Type.String(),
+		TypeID:       int64(event.Type),
+		Date:         event.Date.UTC().Format(time.RFC3339Nano),
+		ActingUserID: event.ActingUserID,
+		MemberID:     event.MemberID,
+		GroupID:      event.GroupID,
+		CollectionID: event.CollectionID,
+		PolicyID:     event.PolicyID,
+		ItemID:       event.ItemID,
+		IPAddress:    event.IPAddress,
+	}
+	if event.Device != nil {
+		record.Device = event.Device.String()
+	}
+
+	// Encode terminates every record with a newline
+	return json.NewEncoder(w).Encode(record)
+}
+
+// cefSeverities raises the severity of the events security teams usually alert on, the others have severity 3.
+var cefSeverities = map[bitwarden.EventType]int{
+	bitwarden.EventUserFailedLogIn:                    5,
+	bitwarden.EventUserFailedLogIn2fa:                 5,
+	bitwarden.EventUserDisabled2fa:                    5,
+	bitwarden.EventUserClientExportedVault:            5,
+	bitwarden.EventOrganizationUserAdminResetPassword: 5,
+	bitwarden.EventOrganizationClientExportedVault:    7,
+	bitwarden.EventOrganizationPurgedVault:            7,
+	bitwarden.EventOrganizationDisabledSso:            7,
+	bitwarden.EventOrganizationDisabledKeyConnector:   7,
+	bitwarden.EventPolicyUpdated:                      5,
+}
+
+// writeCEF writes the event as a line in the ArcSight Common Event Format. The identifiers without a standard CEF
+// field are mapped to the custom string fields cs1 to cs5, labeled by cs1Label to cs5Label.
+func writeCEF(w io.Writer, event bitwarden.Event) error {
+	severity, ok := cefSeverities[event.Type]
+	if !ok {
+		severity = 3
+	}
+
+	extensions := []string{"rt=" + fmt.Sprint(event.Date.UnixMilli())}
+	add := func(key, value string) {
+		if value != "" {
+			extensions = append(extensions, key+"="+cefExtensionEscaper.Replace(value))
+		}
+	}
+	addCustom := func(index int, label, value string) {
+		if value != "" {
+			add(fmt.Sprintf("cs%d", index), value)
+			add(fmt.Sprintf("cs%dLabel", index), label)
+		}
+	}
+	add("src", event.IPAddress)
+	add("suid", event.ActingUserID)
+	add("duid", event.MemberID)
+	addCustom(1, "groupId", event.GroupID)
+	addCustom(2, "collectionId", event.CollectionID)
+	addCustom(3, "policyId", event.PolicyID)
+	addCustom(4, "itemId", event.ItemID)
+	if event.Device != nil {
+		addCustom(5, "device", event.Device.String())
+	}
+
+	_, err := fmt.Fprintf(w, "CEF:0|Bitwarden|Bitwarden|%s|%d|%s|%d|%s\n",
+		cefHeaderEscaper.Replace(version),
+		int64(event.Type),
+		cefHeaderEscaper.Replace(event.Type.String()),
+		severity,
+		strings.Join(extensions, " "),
+	)
+
+	return err
+}
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`)
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+)
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+func testEvent() bitwarden.Event {
+	device := bitwarden.DeviceType(9)
+
+	return bitwarden.Event{
+		Type:         bitwarden.EventGroupUpdated,
+		Date:         time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC),
+		ActingUserID: "user",
+		GroupID:      "a=b",
+		IPAddress:    "192.0.2.1",
+		Device:       &device,
+	}
+}
+
+func TestWriteNDJSON(t *testing.T) {
+	var out bytes.Buffer
+	if err := writeNDJSON(&out, testEvent()); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"type":"group_updated","type_id":1401,"date":"2024-01-02T15:04:05Z","acting_user_id":"user","group_id":"a=b","ip_address":"192.0.2.1","device":"chrome_browser"}` + "\n"
+	if out.String() != expected {
+		t.Errorf("expected %s, got %s", expected, out.String())
+	}
+}
+
+func TestWriteCEF(t *testing.T) {
+	var out bytes.Buffer
+	if err := writeCEF(&out, testEvent()); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `CEF:0|Bitwarden|Bitwarden|dev|1401|group_updated|3|rt=1704207845000 src=192.0.2.1 suid=user cs1=a\=b cs1Label=groupId cs5=chrome_browser cs5Label=device` + "\n"
+	if out.String() != expected {
+		t.Errorf("expected %s, got %s", expected, out.String())
+	}
+}
@@ -0,0 +1,143 @@
+// Command bitwarden-events exports the event logs of a Bitwarden organization to stdout, for ingestion by a SIEM.
+//
+// It authenticates like the provider, with the BITWARDEN_CLIENT_ID and BITWARDEN_CLIENT_SECRET of the organization
+// API key, and resolves the API from BITWARDEN_REGION, BITWARDEN_SERVER_URL, BITWARDEN_API_URL and
+// BITWARDEN_AUTHENTICATION_URL. Every run exports the events since the previous run, tracked in the checkpoint file, in
+// chronological order. The date range of a run overlaps the previous one, to catch the events Bitwarden ingested late,
+// and the events already exported are skipped. Schedule it e.g. every few minutes and pipe its output to the SIEM.
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// version is reported in the User-Agent and the CEF header. It can be set at build time with
+// -ldflags "-X main.version=<version>".
+var version = "dev"
+
+// config holds the command line flags.
+type config struct {
+	format     string
+	checkpoint string
+	since      time.Duration
+	overlap    time.Duration
+	maxRetries int
+}
+
+func main() {
+	var cfg config
+
+	flag.StringVar(&cfg.format, "format", "ndjson", "output format, ndjson or cef")
+	flag.StringVar(&cfg.checkpoint, "checkpoint", "bitwarden-events.checkpoint", "file tracking the exported events, empty to export from -since on every run")
+	flag.DurationVar(&cfg.since, "since", 24*time.Hour, "how far back to export events when there is no checkpoint yet")
+	flag.DurationVar(&cfg.overlap, "overlap", 10*time.Minute, "how far before the last exported event to look for events ingested late")
+	flag.IntVar(&cfg.maxRetries, "max-retries", bitwarden.DefaultRetryConfig.MaxRetries, "maximum number of retries of a request failing with a transient error")
+	flag.Parse()
+
+	if err := run(cfg); err != nil {
+		log.Fatalf("bitwarden-events: %s", err)
+	}
+}
+
+// run exports the events to stdout until done or interrupted.
+func run(cfg config) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return export(ctx, cfg, os.Stdout)
+}
+
+// export writes the events since the checkpoint to out and advances the checkpoint.
+func export(ctx context.Context, cfg config, out io.Writer) error {
+	format, ok := formats[cfg.format]
+	if !ok {
+		return fmt.Errorf("unknown format %q, expected ndjson or cef", cfg.format)
+	}
+
+	client, err := newClient(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	previous, found, err := readCheckpoint(cfg.checkpoint)
+	if err != nil {
+		return err
+	}
+	start := now.Add(-cfg.since)
+	if found {
+		start = previous.start(cfg.overlap)
+	}
+
+	// The API returns the most recent events first, so collect them to export them in chronological order
+	var all []bitwarden.Event
+	it := client.GetEvents(ctx, bitwarden.EventFilter{Start: start, End: now})
+	for it.Next() {
+		all = append(all, it.Value())
+	}
+	if err := it.Err(); err != nil {
+		return fmt.Errorf("listing events: %w", err)
+	}
+
+	events, next, err := selectEvents(all, previous, start, cfg.overlap)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(out)
+	for _, event := range events {
+		if err := format(w, event); err != nil {
+			return fmt.Errorf("writing event: %w", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("writing events: %w", err)
+	}
+
+	// Without any event there is nothing to resume from, so the next run starts from -since again
+	if next.Last.IsZero() {
+		return nil
+	}
+
+	// Only advance the checkpoint once all events are written, so that a failed run exports them again
+	return writeCheckpoint(cfg.checkpoint, next)
+}
+
+// newClient creates the Bitwarden client from the environment, sharing the authentication and retries of the provider.
+func newClient(ctx context.Context, cfg config) (bitwarden.Client, error) {
+	clientID := os.Getenv("BITWARDEN_CLIENT_ID")
+	clientSecret := os.Getenv("BITWARDEN_CLIENT_SECRET")
+	if clientID == "" || clientSecret == "" {
+		return nil, errors.New("set BITWARDEN_CLIENT_ID and BITWARDEN_CLIENT_SECRET to the organization API key")
+	}
+
+	endpoints, err := bitwarden.ResolveEndpoints(
+		os.Getenv("BITWARDEN_API_URL"),
+		os.Getenv("BITWARDEN_AUTHENTICATION_URL"),
+		os.Getenv("BITWARDEN_REGION"),
+		os.Getenv("BITWARDEN_SERVER_URL"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	retry := bitwarden.DefaultRetryConfig
+	retry.MaxRetries = cfg.maxRetries
+
+	return bitwarden.NewClient(ctx, clientID, clientSecret, endpoints.API, endpoints.Authentication,
+		bitwarden.WithRetry(retry),
+		bitwarden.WithUserAgent("bitwarden-events/"+version),
+	)
+}
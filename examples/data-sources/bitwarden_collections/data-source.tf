@@ -0,0 +1,3 @@
+data "bitwarden_collections" "synced" {
+  external_id_regex = "^ldap:"
+}
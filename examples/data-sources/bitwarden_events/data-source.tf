@@ -0,0 +1,10 @@
+# Surface the policy changes of the last week in the plan
+data "bitwarden_events" "policy_changes" {
+  start = timeadd(plantimestamp(), "-168h")
+  types = ["policy_updated"]
+  limit = 20
+}
+
+output "policy_changes" {
+  value = [for e in data.bitwarden_events.policy_changes.events : "${e.date} ${e.acting_user_id} ${e.policy_id}"]
+}
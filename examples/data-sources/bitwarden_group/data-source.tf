@@ -0,0 +1,7 @@
+data "bitwarden_group" "engineering" {
+  name = "Engineering"
+}
+
+data "bitwarden_group" "by_external_id" {
+  external_id = "cn=engineering,ou=groups,dc=example,dc=com"
+}
@@ -0,0 +1,13 @@
+# Grant every team group read-only access to a shared collection
+data "bitwarden_groups" "teams" {
+  name_prefix = "team-"
+}
+
+resource "bitwarden_collection" "shared" {
+  id = "00000000-0000-0000-0000-000000000000"
+
+  groups = [for g in data.bitwarden_groups.teams.groups : {
+    id        = g.id
+    read_only = true
+  }]
+}
@@ -0,0 +1,8 @@
+data "bitwarden_member" "alice" {
+  email = "alice@example.com"
+}
+
+resource "bitwarden_group_member" "alice" {
+  group_id  = bitwarden_group.example.id
+  member_id = data.bitwarden_member.alice.id
+}
@@ -0,0 +1,9 @@
+# Confirmed members of the example.com domain that haven't enabled two-step login yet
+data "bitwarden_members" "confirmed" {
+  status       = "confirmed"
+  email_domain = "example.com"
+}
+
+output "members_without_two_factor" {
+  value = [for m in data.bitwarden_members.confirmed.members : m.email if !m.two_factor_enabled]
+}
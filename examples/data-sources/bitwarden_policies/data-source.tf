@@ -0,0 +1,17 @@
+# Assert that two-step login and strong master passwords are enforced
+data "bitwarden_policies" "this" {}
+
+check "policies" {
+  assert {
+    condition     = data.bitwarden_policies.this.policies["two_factor_authentication"].enabled
+    error_message = "Two-step login must be required for every member."
+  }
+
+  assert {
+    condition = (
+      data.bitwarden_policies.this.policies["master_password"].enabled &&
+      coalesce(jsondecode(data.bitwarden_policies.this.policies["master_password"].data).minLength, 0) >= 14
+    )
+    error_message = "Master passwords must be at least 14 characters long."
+  }
+}
@@ -0,0 +1,12 @@
+resource "bitwarden_collection" "example" {
+  # Collections are created in the web vault, their ID can be found in the collection URL
+  id          = "8b1d7f5a-6a3c-4b0e-9c1b-b0a600d5a1f2"
+  external_id = "external-collection-id"
+
+  groups = [
+    {
+      id        = bitwarden_group.example.id
+      read_only = true
+    },
+  ]
+}
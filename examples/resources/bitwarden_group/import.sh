@@ -0,0 +1,6 @@
+# Groups can be imported using their ID
+terraform import bitwarden_group.example 3f2a1c5e-0b9d-4e7a-8c21-b0a600d5a1f2
+
+# or looked up by their name or external ID, which must match a single group
+terraform import bitwarden_group.example name:Engineering
+terraform import bitwarden_group.example external_id:cn=engineering,ou=groups,dc=example,dc=com
@@ -0,0 +1,2 @@
+# Group members can be imported using the group ID and the member ID, separated by a slash
+terraform import bitwarden_group_member.example 3f2a1c5e-0b9d-4e7a-8c21-b0a600d5a1f2/6d8e2b4a-1c3f-4a5b-9e0d-b0a600d5a1f3
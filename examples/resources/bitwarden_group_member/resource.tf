@@ -0,0 +1,4 @@
+resource "bitwarden_group_member" "example" {
+  group_id  = bitwarden_group.example.id
+  member_id = bitwarden_member.example.id
+}
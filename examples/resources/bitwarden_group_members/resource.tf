@@ -0,0 +1,6 @@
+resource "bitwarden_group_members" "example" {
+  group_id = bitwarden_group.example.id
+  member_ids = [
+    bitwarden_member.example.id,
+  ]
+}
@@ -0,0 +1,6 @@
+# Members can be imported using their ID
+terraform import bitwarden_member.example 6d8e2b4a-1c3f-4a5b-9e0d-b0a600d5a1f3
+
+# or looked up by their email address, compared case-insensitively, or external ID, which must match a single member
+terraform import bitwarden_member.example email:alice@example.com
+terraform import bitwarden_member.example external_id:alice
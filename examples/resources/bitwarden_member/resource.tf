@@ -3,4 +3,12 @@ resource "bitwarden_member" "example" {
   email       = "niels@fake.com"
   external_id = "external-niels-id"
   access_all  = false
+  group_ids   = [bitwarden_group.example.id]
+
+  collections = [
+    {
+      id             = bitwarden_collection.example.id
+      hide_passwords = true
+    },
+  ]
 }
@@ -0,0 +1,2 @@
+# Policies can be imported using their type
+terraform import bitwarden_policy.two_factor two_factor_authentication
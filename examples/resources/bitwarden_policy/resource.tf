@@ -0,0 +1,13 @@
+resource "bitwarden_policy" "two_factor" {
+  type    = "two_factor_authentication"
+  enabled = true
+}
+
+resource "bitwarden_policy" "vault_timeout" {
+  type    = "maximum_vault_timeout"
+  enabled = true
+  data = jsonencode({
+    minutes = 60
+    action  = "lock"
+  })
+}
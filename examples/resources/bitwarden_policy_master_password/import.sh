@@ -0,0 +1,2 @@
+# The master password policy can be imported using its type
+terraform import bitwarden_policy_master_password.this master_password
@@ -0,0 +1,8 @@
+resource "bitwarden_policy_master_password" "this" {
+  min_complexity   = 3
+  min_length       = 14
+  require_upper    = true
+  require_lower    = true
+  require_numbers  = true
+  enforce_on_login = true
+}
@@ -0,0 +1,2 @@
+# The password generator policy can be imported using its type
+terraform import bitwarden_policy_password_generator.this password_generator
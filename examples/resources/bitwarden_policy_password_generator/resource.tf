@@ -0,0 +1,10 @@
+resource "bitwarden_policy_password_generator" "this" {
+  override_password_type = "password"
+  min_length             = 20
+  use_upper              = true
+  use_lower              = true
+  use_numbers            = true
+  use_special            = true
+  min_numbers            = 2
+  min_special            = 2
+}
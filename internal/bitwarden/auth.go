@@ -0,0 +1,93 @@
+package bitwarden
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// tokenRefreshWindow is how long before its expiry an access token is replaced, so that requests never race the
+// expiry of the token they were sent with.
+const tokenRefreshWindow = time.Minute
+
+// tokenRequestTimeout bounds a token request. The request is shared by every API call waiting for the token, so it
+// can't use the context of any of them.
+const tokenRequestTimeout = 30 * time.Second
+
+// TokenError is returned when the client fails to obtain an access token from the identity endpoint, usually because
+// of invalid credentials or a wrong authentication URL.
+type TokenError struct {
+	Err error
+}
+
+func (e *TokenError) Error() string {
+	return "unable to obtain a Bitwarden access token: " + e.Err.Error()
+}
+
+func (e *TokenError) Unwrap() error {
+	return e.Err
+}
+
+// isPermanent reports whether retrying to obtain a token is pointless, because the identity endpoint rejected the
+// credentials.
+func (e *TokenError) isPermanent() bool {
+	var retrieveErr *oauth2.RetrieveError
+	if !errors.As(e.Err, &retrieveErr) || retrieveErr.Response == nil {
+		return false
+	}
+
+	status := retrieveErr.Response.StatusCode
+	return status >= http.StatusBadRequest && status < http.StatusInternalServerError && status != http.StatusTooManyRequests
+}
+
+// credentialsTokenSource fetches a new token on every call, with the HTTP client of the API calls. It's wrapped in a
+// reuse token source by newTokenSource, which decides when a new token is needed.
+type credentialsTokenSource struct {
+	config     *clientcredentials.Config
+	httpClient *http.Client
+}
+
+func (s *credentialsTokenSource) Token() (*oauth2.Token, error) {
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), oauth2.HTTPClient, s.httpClient), tokenRequestTimeout)
+	defer cancel()
+
+	return s.config.Token(ctx)
+}
+
+// tokenSource provides the access token shared by all requests of a client. The token is cached until shortly before
+// it expires.
+type tokenSource struct {
+	reuse oauth2.TokenSource
+}
+
+func newTokenSource(config *clientcredentials.Config, httpClient *http.Client) *tokenSource {
+	return &tokenSource{
+		reuse: oauth2.ReuseTokenSourceWithExpiry(nil, &credentialsTokenSource{config: config, httpClient: httpClient}, tokenRefreshWindow),
+	}
+}
+
+// Token returns the cached token, or waits for a new one when it's about to expire. The wait is abandoned when ctx is
+// done, while the token request itself goes on for the other requests of the client.
+func (s *tokenSource) Token(ctx context.Context) (*oauth2.Token, error) {
+	type result struct {
+		token *oauth2.Token
+		err   error
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		token, err := s.reuse.Token()
+		done <- result{token: token, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.token, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -2,30 +2,123 @@ package bitwarden
 
 import (
 	"context"
-	"fmt"
 	"io"
 	"net/http"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
+	"golang.org/x/time/rate"
 )
 
 type Client interface {
 	// Group
 	CreateGroup(ctx context.Context, group Group) (*Group, error)
 	GetGroup(ctx context.Context, id string) (*Group, error)
+	ListGroups(ctx context.Context) *Iterator[Group]
 	UpdateGroup(ctx context.Context, id string, group Group) (*Group, error)
 	DeleteGroup(ctx context.Context, id string) error
+	GetGroupMemberIDs(ctx context.Context, id string) ([]string, error)
+	UpdateGroupMemberIDs(ctx context.Context, id string, memberIDs []string) error
 
 	// Member
 	CreateMember(ctx context.Context, group Member) (*ResponseMember, error)
 	GetMember(ctx context.Context, id string) (*ResponseMember, error)
+	ListMembers(ctx context.Context) *Iterator[ResponseMember]
 	UpdateMember(ctx context.Context, id string, group Member) (*ResponseMember, error)
 	DeleteMember(ctx context.Context, id string) error
+	GetMemberGroupIDs(ctx context.Context, id string) ([]string, error)
+	UpdateMemberGroupIDs(ctx context.Context, id string, groupIDs []string) error
+
+	// Collection
+	GetCollection(ctx context.Context, id string) (*Collection, error)
+	ListCollections(ctx context.Context) *Iterator[Collection]
+	UpdateCollection(ctx context.Context, id string, collection Collection) (*Collection, error)
+
+	// Policy
+	GetPolicy(ctx context.Context, policyType PolicyType) (*Policy, error)
+	ListPolicies(ctx context.Context) *Iterator[Policy]
+	UpdatePolicy(ctx context.Context, policy Policy) (*Policy, error)
+
+	// Event
+	GetEvents(ctx context.Context, filter EventFilter) *Iterator[Event]
 }
+
 type client struct {
 	apiURL      string
-	oauthConfig *clientcredentials.Config
+	httpClient  *http.Client
+	transport   http.RoundTripper
+	userAgent   string
+	timeout     time.Duration
+	tokenSource *tokenSource
+	retry       RetryConfig
+	// limiter and inFlight throttle the requests sent by all resources sharing the client, nil means unlimited.
+	limiter  *rate.Limiter
+	inFlight chan struct{}
+}
+
+// Option customizes the client created by NewClient.
+type Option func(c *client)
+
+// WithRetry overrides DefaultRetryConfig, which controls how requests failing with a transient error are retried.
+func WithRetry(retry RetryConfig) Option {
+	return func(c *client) {
+		c.retry = retry
+	}
+}
+
+// WithRateLimit limits the client to the given number of requests per second, allowing short bursts of up to burst
+// requests.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(c *client) {
+		if burst < 1 {
+			burst = 1
+		}
+		c.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+}
+
+// WithMaxConcurrentRequests limits the number of requests the client has in flight at the same time. Values below 1
+// leave the number of requests unlimited.
+func WithMaxConcurrentRequests(max int) Option {
+	return func(c *client) {
+		if max < 1 {
+			c.inFlight = nil
+			return
+		}
+		c.inFlight = make(chan struct{}, max)
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for both the token endpoint and the API calls, instead of
+// http.DefaultClient. The client itself isn't modified by the other options.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTransport sets the transport of the HTTP client, e.g. to go through a proxy, trust a corporate CA or record
+// requests.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *client) {
+		c.transport = transport
+	}
+}
+
+// WithUserAgent sets the User-Agent header of every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithTimeout limits the time a single attempt of a request may take, including reading the response body.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *client) {
+		c.timeout = timeout
+	}
 }
 
 // NewClient creates a new BitWarden API client to interact with the BitWarden Public API
@@ -33,38 +126,145 @@ type client struct {
 // See the BitWarden documentation for more information about the API
 // https://bitwarden.com/help/public-api/
 // https://bitwarden.com/help/api/
-func NewClient(_ context.Context, clientID, clientSecret, apiUrl, authUrl string) (Client, error) {
+func NewClient(_ context.Context, clientID, clientSecret, apiUrl, authUrl string, opts ...Option) (Client, error) {
 	c := &client{
-		apiURL: apiUrl,
-		oauthConfig: &clientcredentials.Config{
-			ClientID:     clientID,
-			ClientSecret: clientSecret,
-			TokenURL:     authUrl,
-			Scopes:       []string{"api.organization"},
-			AuthStyle:    oauth2.AuthStyleInParams,
-		},
+		apiURL:     apiUrl,
+		httpClient: http.DefaultClient,
+		retry:      DefaultRetryConfig,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.httpClient = c.buildHTTPClient()
+
+	oauthConfig := &clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     authUrl,
+		Scopes:       []string{"api.organization"},
+		AuthStyle:    oauth2.AuthStyleInParams,
 	}
+	c.tokenSource = newTokenSource(oauthConfig, c.httpClient)
 
 	return c, nil
 }
 
+// buildHTTPClient applies the transport options on a copy of the configured HTTP client.
+func (c *client) buildHTTPClient() *http.Client {
+	httpClient := *c.httpClient
+
+	if c.transport != nil {
+		httpClient.Transport = c.transport
+	}
+
+	if c.userAgent != "" {
+		transport := httpClient.Transport
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+		httpClient.Transport = &userAgentTransport{userAgent: c.userAgent, next: transport}
+	}
+
+	if c.timeout > 0 {
+		httpClient.Timeout = c.timeout
+	}
+
+	return &httpClient
+}
+
+// doRequest sends the request, retrying it according to the retry configuration of the client, and returns the
+// response body on success.
 func (c *client) doRequest(ctx context.Context, req *http.Request) ([]byte, error) {
 	req.Header.Set("Content-Type", "application/json")
 
-	res, err := c.oauthConfig.Client(ctx).Do(req)
+	for retry := 0; ; retry++ {
+		body, retryAfter, err := c.send(ctx, req)
+		if err == nil {
+			return body, nil
+		}
+
+		if retry >= c.retry.MaxRetries || !c.retry.shouldRetry(req.Method, err) || ctx.Err() != nil {
+			return nil, err
+		}
+
+		wait := c.retry.backoff(retry, retryAfter)
+		tflog.Debug(ctx, "Retrying Bitwarden API request", map[string]interface{}{
+			"method": req.Method,
+			"url":    req.URL.String(),
+			"retry":  retry + 1,
+			"wait":   wait.String(),
+			"error":  err.Error(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+
+		// The body of the previous attempt has been consumed, so rewind it
+		if req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// throttleError is returned when the rate limit of the client can't admit a request before its context is done. The
+// request was never sent, and a retry within the same context can't be admitted either.
+type throttleError struct {
+	err error
+}
+
+func (e *throttleError) Error() string {
+	return "request not sent due to the client rate limit: " + e.err.Error()
+}
+
+func (e *throttleError) Unwrap() error {
+	return e.err
+}
+
+// send performs a single attempt of the request, returning the wait requested by the server in case of failure.
+func (c *client) send(ctx context.Context, req *http.Request) ([]byte, time.Duration, error) {
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, 0, &throttleError{err: err}
+		}
+	}
+
+	if c.inFlight != nil {
+		select {
+		case c.inFlight <- struct{}{}:
+			defer func() { <-c.inFlight }()
+		case <-ctx.Done():
+			return nil, 0, ctx.Err()
+		}
+	}
+
+	token, err := c.tokenSource.Token(ctx)
+	if err != nil {
+		return nil, 0, &TokenError{Err: err}
+	}
+	token.SetAuthHeader(req)
+
+	res, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	defer res.Body.Close()
 
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+		return nil, parseRetryAfter(res.Header.Get("Retry-After")), newAPIError(res.StatusCode, body)
 	}
 
-	return body, err
+	return body, 0, nil
 }
@@ -0,0 +1,208 @@
+package bitwarden
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	c := newTestClient(t, server, WithMaxConcurrentRequests(2))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetGroup(context.Background(), "group-id"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Fatalf("expected at most 2 requests in flight, got %d", maxInFlight)
+	}
+}
+
+func TestTokenIsReused(t *testing.T) {
+	var tokenCalls int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&tokenCalls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c := newTestClient(t, server)
+	for i := 0; i < 3; i++ {
+		if _, err := c.GetGroup(context.Background(), "group-id"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if tokenCalls != 1 {
+		t.Fatalf("expected a single token request, got %d", tokenCalls)
+	}
+}
+
+func TestTokenError(t *testing.T) {
+	var tokenCalls int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&tokenCalls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	_, err := newTestClient(t, server).GetGroup(context.Background(), "group-id")
+
+	var tokenErr *TokenError
+	if !errors.As(err, &tokenErr) {
+		t.Fatalf("expected a token error, got %v", err)
+	}
+
+	if tokenCalls != 1 {
+		t.Fatalf("expected invalid credentials not to be retried, got %d token requests", tokenCalls)
+	}
+}
+
+func TestTokenRequestHonoursContext(t *testing.T) {
+	release := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(_ http.ResponseWriter, _ *http.Request) {
+		<-release
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	defer close(release)
+
+	c := newTestClient(t, server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.GetGroup(ctx, "group-id")
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected the deadline to be exceeded, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the token request to be abandoned when the context is done")
+	}
+}
+
+type recordingTransport struct {
+	mu         sync.Mutex
+	userAgents []string
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	t.userAgents = append(t.userAgents, req.Header.Get("User-Agent"))
+	t.mu.Unlock()
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestTransportOptions(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	transport := &recordingTransport{}
+	c := newTestClient(t, server, WithTransport(transport), WithUserAgent("test-agent/1.0"))
+	if _, err := c.GetGroup(context.Background(), "group-id"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Both the token request and the API call go through the transport
+	if len(transport.userAgents) != 2 {
+		t.Fatalf("expected 2 requests through the transport, got %d", len(transport.userAgents))
+	}
+	for _, userAgent := range transport.userAgents {
+		if userAgent != "test-agent/1.0" {
+			t.Fatalf("unexpected user agent %q", userAgent)
+		}
+	}
+}
+
+func TestMaxConcurrentRequestsBelowOneIsUnlimited(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := newTestClient(t, server, WithMaxConcurrentRequests(0)).GetGroup(ctx, "group-id"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRateLimitDeadlineIsNotRetried(t *testing.T) {
+	var calls int32
+	server := newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	// A single request per hour, so the second request can't be admitted before the deadline
+	c := newTestClient(t, server, WithRateLimit(1.0/3600, 1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if _, err := c.GetGroup(ctx, "group-id"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := c.GetGroup(ctx, "group-id")
+	var throttleErr *throttleError
+	if !errors.As(err, &throttleErr) || calls != 1 {
+		t.Fatalf("expected a throttle error without sending the request, got %v after %d calls", err, calls)
+	}
+	if DefaultRetryConfig.shouldRetry(http.MethodGet, err) {
+		t.Error("expected a throttle error not to be retried")
+	}
+}
@@ -0,0 +1,81 @@
+package bitwarden
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// AssociationWithPermissions links a group or member to a collection, together with the permissions granted on it.
+type AssociationWithPermissions struct {
+	ID            string `json:"id"`
+	ReadOnly      bool   `json:"readOnly"`
+	HidePasswords bool   `json:"hidePasswords"`
+	Manage        bool   `json:"manage"`
+}
+
+// Collection is the Public API representation of a collection. The collection name is encrypted with the organization
+// key and is therefore not exposed by the Public API, which is also why collections can't be created through it.
+type Collection struct {
+	ID         string                       `json:"id"`
+	Object     string                       `json:"object"`
+	ExternalId string                       `json:"externalId"`
+	Groups     []AssociationWithPermissions `json:"groups"`
+}
+
+func (c *client) GetCollection(ctx context.Context, id string) (*Collection, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/collections/%s", c.apiURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	collection := Collection{}
+	err = json.Unmarshal(body, &collection)
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection, nil
+}
+
+// ListCollections iterates over all collections of the organization.
+func (c *client) ListCollections(ctx context.Context) *Iterator[Collection] {
+	return newIterator[Collection](ctx, c, "/collections", nil)
+}
+
+func (c *client) UpdateCollection(ctx context.Context, id string, collection Collection) (*Collection, error) {
+	// The API rejects a null groups array, so always send an empty one instead.
+	if collection.Groups == nil {
+		collection.Groups = make([]AssociationWithPermissions, 0)
+	}
+
+	rb, err := json.Marshal(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/collections/%s", c.apiURL, id), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	newCollection := Collection{}
+	err = json.Unmarshal(body, &newCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newCollection, nil
+}
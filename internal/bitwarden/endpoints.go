@@ -0,0 +1,101 @@
+package bitwarden
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Endpoints are the URLs of the Bitwarden Public API and of the identity endpoint issuing its access tokens.
+type Endpoints struct {
+	API            string
+	Authentication string
+}
+
+// cloudEndpoints maps the regions of Bitwarden cloud to their endpoints.
+var cloudEndpoints = map[string]Endpoints{
+	"us": {
+		API:            "https://api.bitwarden.com/public",
+		Authentication: "https://identity.bitwarden.com/connect/token",
+	},
+	"eu": {
+		API:            "https://api.bitwarden.eu/public",
+		Authentication: "https://identity.bitwarden.eu/connect/token",
+	},
+}
+
+// selfHostedEndpoints derives the endpoints of a self-hosted Bitwarden server from its base URL.
+func selfHostedEndpoints(serverUrl string) Endpoints {
+	return Endpoints{
+		API:            serverUrl + "/api/public",
+		Authentication: serverUrl + "/identity/connect/token",
+	}
+}
+
+// ResolveEndpoints returns the endpoints to use for the given settings, any of which may be empty. The endpoints are
+// derived from either region or serverUrl, explicitly set URLs must then match the derived ones. Without region
+// and serverUrl, unset URLs default to the US region.
+func ResolveEndpoints(apiUrl, authUrl, region, serverUrl string) (Endpoints, error) {
+	if region != "" && serverUrl != "" {
+		return Endpoints{}, fmt.Errorf("region %q and server_url %q can't be combined, set region for Bitwarden cloud or server_url for a self-hosted server", region, serverUrl)
+	}
+
+	derived := cloudEndpoints["us"]
+	switch {
+	case region != "":
+		var ok bool
+		derived, ok = cloudEndpoints[region]
+		if !ok {
+			return Endpoints{}, fmt.Errorf("unknown region %q, expected one of: us, eu", region)
+		}
+	case serverUrl != "":
+		if err := CheckURL(serverUrl); err != nil {
+			return Endpoints{}, fmt.Errorf("invalid server_url: %w", err)
+		}
+		derived = selfHostedEndpoints(serverUrl)
+	}
+
+	resolved := derived
+	if apiUrl != "" {
+		resolved.API = apiUrl
+	}
+	if authUrl != "" {
+		resolved.Authentication = authUrl
+	}
+
+	if region != "" || serverUrl != "" {
+		if resolved.API != derived.API {
+			return Endpoints{}, fmt.Errorf("api_url %q doesn't match %q derived from the region or server_url, remove one of them", resolved.API, derived.API)
+		}
+		if resolved.Authentication != derived.Authentication {
+			return Endpoints{}, fmt.Errorf("authentication_url %q doesn't match %q derived from the region or server_url, remove one of them", resolved.Authentication, derived.Authentication)
+		}
+	}
+
+	if err := CheckURL(resolved.API); err != nil {
+		return Endpoints{}, fmt.Errorf("invalid api_url: %w", err)
+	}
+	if err := CheckURL(resolved.Authentication); err != nil {
+		return Endpoints{}, fmt.Errorf("invalid authentication_url: %w", err)
+	}
+
+	return resolved, nil
+}
+
+// CheckURL ensures value is an absolute HTTP(S) URL without a trailing slash, as the client appends paths to it.
+func CheckURL(value string) error {
+	u, err := url.Parse(value)
+	if err != nil {
+		return err
+	}
+
+	if (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return fmt.Errorf("%q must be an absolute http or https URL", value)
+	}
+
+	if strings.HasSuffix(value, "/") {
+		return fmt.Errorf("%q must not end with a trailing slash", value)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package bitwarden
+
+import (
+	"testing"
+)
+
+func TestResolveEndpoints(t *testing.T) {
+	for name, tc := range map[string]struct {
+		apiUrl, authUrl, region, serverUrl string
+		expected                           Endpoints
+	}{
+		"defaults": {
+			expected: cloudEndpoints["us"],
+		},
+		"region": {
+			region:   "eu",
+			expected: cloudEndpoints["eu"],
+		},
+		"self-hosted": {
+			serverUrl: "https://bitwarden.example.com",
+			expected: Endpoints{
+				API:            "https://bitwarden.example.com/api/public",
+				Authentication: "https://bitwarden.example.com/identity/connect/token",
+			},
+		},
+		"explicit urls": {
+			apiUrl:  "https://api.example.com/public",
+			authUrl: "https://identity.example.com/connect/token",
+			expected: Endpoints{
+				API:            "https://api.example.com/public",
+				Authentication: "https://identity.example.com/connect/token",
+			},
+		},
+		"explicit url matching region": {
+			apiUrl:   "https://api.bitwarden.eu/public",
+			region:   "eu",
+			expected: cloudEndpoints["eu"],
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resolved, err := ResolveEndpoints(tc.apiUrl, tc.authUrl, tc.region, tc.serverUrl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resolved != tc.expected {
+				t.Fatalf("expected %+v, got %+v", tc.expected, resolved)
+			}
+		})
+	}
+}
+
+func TestResolveEndpointsRejectsInvalidSettings(t *testing.T) {
+	for name, tc := range map[string]struct {
+		apiUrl, authUrl, region, serverUrl string
+	}{
+		"region and server url":  {region: "eu", serverUrl: "https://bitwarden.example.com"},
+		"unknown region":         {region: "ap"},
+		"url mismatching region": {region: "eu", apiUrl: "https://api.bitwarden.com/public"},
+		"url mismatching server": {serverUrl: "https://bitwarden.example.com", authUrl: "https://identity.bitwarden.com/connect/token"},
+		"trailing slash":         {apiUrl: "https://api.bitwarden.com/public/"},
+		"server trailing slash":  {serverUrl: "https://bitwarden.example.com/"},
+		"relative url":           {authUrl: "identity.bitwarden.com/connect/token"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ResolveEndpoints(tc.apiUrl, tc.authUrl, tc.region, tc.serverUrl); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package bitwarden
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// APIError is returned by the client whenever the Bitwarden API responds with an unexpected status code. Bitwarden
+// reports failures with an error object such as:
+//
+//	{
+//		"object":"error",
+//		"message":"Errors have occurred.",
+//		"errors": {
+//			"An error has occurred.":["Value cannot be null. (Parameter 'source')"]
+//		}
+//	}
+//
+// where errors maps the offending request field, or a generic description, to its validation messages.
+type APIError struct {
+	StatusCode int                 `json:"-"`
+	Object     string              `json:"object"`
+	Message    string              `json:"message"`
+	Errors     map[string][]string `json:"errors"`
+
+	body []byte
+}
+
+func newAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		body:       body,
+	}
+
+	// Not every response carries an error object, e.g. a 404 has an empty body, so the raw body is kept as fallback
+	_ = json.Unmarshal(body, apiErr)
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		if len(e.body) == 0 {
+			return fmt.Sprintf("status: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+		}
+		return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.body)
+	}
+
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("status: %d, message: %s", e.StatusCode, e.Message)
+	}
+
+	return fmt.Sprintf("status: %d, message: %s %s", e.StatusCode, e.Message, strings.Join(e.FieldErrors(), " "))
+}
+
+// FieldErrors returns the validation messages of the error formatted as "<field>: <message>", sorted by field.
+func (e *APIError) FieldErrors() []string {
+	fields := make([]string, 0, len(e.Errors))
+	for field := range e.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	messages := make([]string, 0, len(fields))
+	for _, field := range fields {
+		messages = append(messages, fmt.Sprintf("%s: %s", field, strings.Join(e.Errors[field], " ")))
+	}
+
+	return messages
+}
+
+// IsNotFound reports whether err is an APIError for a resource that doesn't exist (anymore).
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an APIError for a request that conflicts with the current state, e.g. inviting
+// a member that is already part of the organization.
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsUnauthorized reports whether err is an APIError caused by missing or insufficient credentials.
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized, http.StatusForbidden)
+}
+
+// IsValidation reports whether err is an APIError for a request that was rejected because of invalid input.
+func IsValidation(err error) bool {
+	return hasStatusCode(err, http.StatusBadRequest)
+}
+
+// IsRateLimited reports whether err is an APIError for a request that was rejected because of rate limiting.
+func IsRateLimited(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+func hasStatusCode(err error, statusCodes ...int) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	for _, statusCode := range statusCodes {
+		if apiErr.StatusCode == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,32 @@
+package bitwarden
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNewAPIError(t *testing.T) {
+	body := []byte(`{"object":"error","message":"The model state is invalid.","errors":{"Email":["The Email field is required."]}}`)
+	err := fmt.Errorf("wrapped: %w", newAPIError(400, body))
+
+	if !IsValidation(err) || IsNotFound(err) {
+		t.Fatalf("unexpected classification of %v", err)
+	}
+
+	expected := "wrapped: status: 400, message: The model state is invalid. Email: The Email field is required."
+	if err.Error() != expected {
+		t.Fatalf("expected %q, got %q", expected, err.Error())
+	}
+}
+
+func TestNewAPIErrorWithoutBody(t *testing.T) {
+	err := newAPIError(404, nil)
+
+	if !IsNotFound(err) {
+		t.Fatalf("expected %v to be a not found error", err)
+	}
+
+	if err.Error() != "status: 404 Not Found" {
+		t.Fatalf("unexpected error message %q", err.Error())
+	}
+}
@@ -0,0 +1,65 @@
+package bitwarden
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGetEvents(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/events" || query.Get("start") != "2024-01-01T00:00:00Z" || query.Get("actingUserId") != "user" || query.Get("end") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch query.Get("continuationToken") {
+		case "":
+			_, _ = w.Write([]byte(`{"object":"list","data":[{"object":"event","type":1400,"groupId":"group","actingUserId":"user","date":"2024-01-02T10:00:00Z","device":9,"ipAddress":"192.0.2.1"}],"continuationToken":"page-2"}`))
+		case "page-2":
+			_, _ = w.Write([]byte(`{"object":"list","data":[{"object":"event","type":9999,"actingUserId":"user","date":"2024-01-01T10:00:00Z","device":null}],"continuationToken":null}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	events, err := newTestClient(t, server).GetEvents(context.Background(), EventFilter{
+		Start:        time.Date(2024, 1, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600)),
+		ActingUserID: "user",
+	}).Collect()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %+v", events)
+	}
+	if e := events[0]; e.Type != EventGroupCreated || e.Type.String() != "group_created" || e.GroupID != "group" ||
+		e.Device == nil || e.Device.String() != "chrome_browser" || !e.Date.Equal(time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected first event %+v", e)
+	}
+	if e := events[1]; e.Type.String() != "9999" || e.Device != nil {
+		t.Errorf("unexpected second event %+v", e)
+	}
+}
+
+func TestGetEventsCompletesDateRange(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("start") != "2024-01-01T10:00:00.5Z" || query.Get("end") != "2024-01-31T10:00:00.5Z" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"object":"list","data":[],"continuationToken":null}`))
+	})
+
+	_, err := newTestClient(t, server).GetEvents(context.Background(), EventFilter{
+		End: time.Date(2024, 1, 31, 10, 0, 0, 500000000, time.UTC),
+	}).Collect()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -9,20 +9,26 @@ import (
 )
 
 type Group struct {
-	ID         string `json:"id"`
-	Object     string `json:"object"`
-	Name       string `json:"name"`
-	ExternalId string `json:"externalId"`
-	AccessAll  bool   `json:"accessAll"`
+	ID          string                       `json:"id"`
+	Object      string                       `json:"object"`
+	Name        string                       `json:"name"`
+	ExternalId  string                       `json:"externalId"`
+	AccessAll   bool                         `json:"accessAll"`
+	Collections []AssociationWithPermissions `json:"collections"`
 }
 
 func (c *client) CreateGroup(ctx context.Context, group Group) (*Group, error) {
+	// The API rejects a null Collection array, so always send an empty one instead
+	if group.Collections == nil {
+		group.Collections = make([]AssociationWithPermissions, 0)
+	}
+
 	rb, err := json.Marshal(group)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/groups", c.apiURL), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/groups", c.apiURL), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +48,7 @@ func (c *client) CreateGroup(ctx context.Context, group Group) (*Group, error) {
 }
 
 func (c *client) GetGroup(ctx context.Context, id string) (*Group, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/groups/%s", c.apiURL, id), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/groups/%s", c.apiURL, id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -61,13 +67,23 @@ func (c *client) GetGroup(ctx context.Context, id string) (*Group, error) {
 	return &newGroup, nil
 }
 
+// ListGroups iterates over all groups of the organization.
+func (c *client) ListGroups(ctx context.Context) *Iterator[Group] {
+	return newIterator[Group](ctx, c, "/groups", nil)
+}
+
 func (c *client) UpdateGroup(ctx context.Context, id string, group Group) (*Group, error) {
+	// The API rejects a null Collection array, so always send an empty one instead
+	if group.Collections == nil {
+		group.Collections = make([]AssociationWithPermissions, 0)
+	}
+
 	rb, err := json.Marshal(group)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/groups/%s", c.apiURL, id), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/groups/%s", c.apiURL, id), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +103,49 @@ func (c *client) UpdateGroup(ctx context.Context, id string, group Group) (*Grou
 }
 
 func (c *client) DeleteGroup(ctx context.Context, id string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/groups/%s", c.apiURL, id), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/groups/%s", c.apiURL, id), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(ctx, req)
+
+	return err
+}
+
+func (c *client) GetGroupMemberIDs(ctx context.Context, id string) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/groups/%s/member-ids", c.apiURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs := make([]string, 0)
+	err = json.Unmarshal(body, &memberIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return memberIDs, nil
+}
+
+func (c *client) UpdateGroupMemberIDs(ctx context.Context, id string, memberIDs []string) error {
+	if memberIDs == nil {
+		memberIDs = make([]string, 0)
+	}
+
+	rb, err := json.Marshal(struct {
+		MemberIDs []string `json:"memberIds"`
+	}{memberIDs})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/groups/%s/member-ids", c.apiURL, id), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
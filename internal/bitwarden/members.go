@@ -20,18 +20,13 @@ const (
 	Custom  OrganizationUserType = 4
 )
 
-type Collection struct {
-	ID       string `json:"id"`
-	ReadOnly bool   `json:"readOnly"`
-}
-
 type Member struct {
-	Type                  OrganizationUserType `json:"type"`
-	AccessAll             bool                 `json:"accessAll"`
-	ExternalId            string               `json:"externalId"`
-	Email                 string               `json:"email"`
-	ResetPasswordEnrolled bool                 `json:"resetPasswordEnrolled"`
-	Collections           []Collection         `json:"collections"`
+	Type                  OrganizationUserType         `json:"type"`
+	AccessAll             bool                         `json:"accessAll"`
+	ExternalId            string                       `json:"externalId"`
+	Email                 string                       `json:"email"`
+	ResetPasswordEnrolled bool                         `json:"resetPasswordEnrolled"`
+	Collections           []AssociationWithPermissions `json:"collections"`
 }
 
 type ResponseMember struct {
@@ -45,12 +40,12 @@ type ResponseMember struct {
 	//  Accepted 	= 1
 	//  Confirmed 	= 2
 	//  Revoked 	= -1
-	Status int64 `json:"status"`
+	Status           int64 `json:"status"`
+	TwoFactorEnabled bool  `json:"twoFactorEnabled"`
 }
 
 func (c *client) CreateMember(ctx context.Context, member Member) (*ResponseMember, error) {
-	// TODO: we don't support a collection at the moment, but an empty Collection array is needed otherwise the member
-	// creation fails with the following error:
+	// A null Collection array makes the member creation fail with the following error, so send an empty one instead:
 	//{
 	//	"object":"error",
 	//	"message":"Errors have occurred.",
@@ -58,7 +53,9 @@ func (c *client) CreateMember(ctx context.Context, member Member) (*ResponseMemb
 	// 		"An error has occurred.":["Value cannot be null. (Parameter 'source')"]
 	//	}
 	//}
-	member.Collections = make([]Collection, 0)
+	if member.Collections == nil {
+		member.Collections = make([]AssociationWithPermissions, 0)
+	}
 
 	rb, err := json.Marshal(member)
 	if err != nil {
@@ -67,7 +64,7 @@ func (c *client) CreateMember(ctx context.Context, member Member) (*ResponseMemb
 
 	tflog.Debug(ctx, string(rb))
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/members", c.apiURL), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/members", c.apiURL), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +86,7 @@ func (c *client) CreateMember(ctx context.Context, member Member) (*ResponseMemb
 }
 
 func (c *client) GetMember(ctx context.Context, id string) (*ResponseMember, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/members/%s", c.apiURL, id), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/members/%s", c.apiURL, id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -108,13 +105,23 @@ func (c *client) GetMember(ctx context.Context, id string) (*ResponseMember, err
 	return &newMember, nil
 }
 
+// ListMembers iterates over all members of the organization.
+func (c *client) ListMembers(ctx context.Context) *Iterator[ResponseMember] {
+	return newIterator[ResponseMember](ctx, c, "/members", nil)
+}
+
 func (c *client) UpdateMember(ctx context.Context, id string, member Member) (*ResponseMember, error) {
+	// Same as for CreateMember, the API rejects a null Collection array
+	if member.Collections == nil {
+		member.Collections = make([]AssociationWithPermissions, 0)
+	}
+
 	rb, err := json.Marshal(member)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/members/%s", c.apiURL, id), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/members/%s", c.apiURL, id), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +141,49 @@ func (c *client) UpdateMember(ctx context.Context, id string, member Member) (*R
 }
 
 func (c *client) DeleteMember(ctx context.Context, id string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/members/%s", c.apiURL, id), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/members/%s", c.apiURL, id), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(ctx, req)
+
+	return err
+}
+
+func (c *client) GetMemberGroupIDs(ctx context.Context, id string) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/members/%s/group-ids", c.apiURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	groupIDs := make([]string, 0)
+	err = json.Unmarshal(body, &groupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupIDs, nil
+}
+
+func (c *client) UpdateMemberGroupIDs(ctx context.Context, id string, groupIDs []string) error {
+	if groupIDs == nil {
+		groupIDs = make([]string, 0)
+	}
+
+	rb, err := json.Marshal(struct {
+		GroupIDs []string `json:"groupIds"`
+	}{groupIDs})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/members/%s/group-ids", c.apiURL, id), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
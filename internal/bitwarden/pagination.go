@@ -0,0 +1,131 @@
+package bitwarden
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// listResponse is the envelope the Public API wraps around every list endpoint.
+type listResponse[T any] struct {
+	Object            string  `json:"object"`
+	Data              []T     `json:"data"`
+	ContinuationToken *string `json:"continuationToken"`
+}
+
+// Iterator walks through the items of a list endpoint. The next page is only requested once all items of the current
+// page have been consumed, following the continuation token returned by the API. Use it like a bufio.Scanner:
+//
+//	it := client.ListGroups(ctx)
+//	for it.Next() {
+//		group := it.Value()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	ctx   context.Context
+	c     *client
+	path  string
+	query url.Values
+
+	page    []T
+	current T
+	token   string
+	done    bool
+	err     error
+}
+
+func newIterator[T any](ctx context.Context, c *client, path string, query url.Values) *Iterator[T] {
+	if query == nil {
+		query = url.Values{}
+	}
+
+	return &Iterator[T]{
+		ctx:   ctx,
+		c:     c,
+		path:  path,
+		query: query,
+	}
+}
+
+// Next advances the iterator to the next item, fetching the next page when needed. It returns false once all items
+// have been consumed or a request failed, which Err reports.
+func (it *Iterator[T]) Next() bool {
+	for len(it.page) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+
+		it.err = it.fetch()
+	}
+
+	it.current, it.page = it.page[0], it.page[1:]
+
+	return true
+}
+
+// Value returns the current item, as advanced to by Next.
+func (it *Iterator[T]) Value() T {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Collect consumes the remaining items of the iterator and returns them all at once.
+func (it *Iterator[T]) Collect() ([]T, error) {
+	items := make([]T, 0)
+	for it.Next() {
+		items = append(items, it.Value())
+	}
+
+	return items, it.Err()
+}
+
+// fetch requests the next page and ends the iteration when the API doesn't return a continuation token.
+func (it *Iterator[T]) fetch() error {
+	query := url.Values{}
+	for key, values := range it.query {
+		query[key] = values
+	}
+	if it.token != "" {
+		query.Set("continuationToken", it.token)
+	}
+
+	endpoint := fmt.Sprintf("%s%s", it.c.apiURL, it.path)
+	if len(query) != 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+	}
+
+	req, err := http.NewRequestWithContext(it.ctx, "GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	body, err := it.c.doRequest(it.ctx, req)
+	if err != nil {
+		return err
+	}
+
+	list := listResponse[T]{}
+	err = json.Unmarshal(body, &list)
+	if err != nil {
+		return err
+	}
+
+	// Guard against a server handing out the same token again, which would otherwise loop forever
+	if list.ContinuationToken == nil || *list.ContinuationToken == "" || *list.ContinuationToken == it.token {
+		it.done = true
+	} else {
+		it.token = *list.ContinuationToken
+	}
+	it.page = list.Data
+
+	return nil
+}
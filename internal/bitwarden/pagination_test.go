@@ -0,0 +1,69 @@
+package bitwarden
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func newPagedClient(t *testing.T, calls *int32) Client {
+	t.Helper()
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		if r.URL.Path != "/groups" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.URL.Query().Get("continuationToken") {
+		case "":
+			_, _ = w.Write([]byte(`{"object":"list","data":[{"id":"1"},{"id":"2"}],"continuationToken":"page-2"}`))
+		case "page-2":
+			_, _ = w.Write([]byte(`{"object":"list","data":[{"id":"3"}],"continuationToken":null}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	return newTestClient(t, server)
+}
+
+func TestIteratorFollowsContinuationToken(t *testing.T) {
+	var calls int32
+	groups, err := newPagedClient(t, &calls).ListGroups(context.Background()).Collect()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(groups) != 3 || groups[0].ID != "1" || groups[2].ID != "3" || calls != 2 {
+		t.Fatalf("expected 3 groups from 2 pages, got %+v after %d calls", groups, calls)
+	}
+}
+
+func TestIteratorFetchesPagesLazily(t *testing.T) {
+	var calls int32
+	it := newPagedClient(t, &calls).ListGroups(context.Background())
+
+	for it.Next() {
+		if it.Value().ID == "2" {
+			break
+		}
+	}
+
+	if it.Err() != nil || calls != 1 {
+		t.Fatalf("expected a single call, got %v after %d calls", it.Err(), calls)
+	}
+}
+
+func TestIteratorReportsErrors(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	it := newTestClient(t, server).ListMembers(context.Background())
+	if it.Next() || !IsUnauthorized(it.Err()) {
+		t.Fatalf("expected an unauthorized error, got %v", it.Err())
+	}
+}
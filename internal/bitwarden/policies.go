@@ -0,0 +1,162 @@
+package bitwarden
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// PolicyType identifies an organization policy, see
+// https://github.com/bitwarden/server/blob/main/src/Core/AdminConsole/Enums/PolicyType.cs
+type PolicyType int64
+
+const (
+	PolicyTwoFactorAuthentication    PolicyType = 0
+	PolicyMasterPassword             PolicyType = 1
+	PolicyPasswordGenerator          PolicyType = 2
+	PolicySingleOrg                  PolicyType = 3
+	PolicyRequireSso                 PolicyType = 4
+	PolicyPersonalOwnership          PolicyType = 5
+	PolicyDisableSend                PolicyType = 6
+	PolicySendOptions                PolicyType = 7
+	PolicyResetPassword              PolicyType = 8
+	PolicyMaximumVaultTimeout        PolicyType = 9
+	PolicyDisablePersonalVaultExport PolicyType = 10
+)
+
+// Policy is an organization policy. Only some policy types have settings, which are held by Data and can be decoded
+// into the matching typed model, e.g. MasterPasswordPolicyData, with DecodeData.
+type Policy struct {
+	Object  string          `json:"object,omitempty"`
+	ID      string          `json:"id,omitempty"`
+	Type    PolicyType      `json:"type"`
+	Enabled bool            `json:"enabled"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// DecodeData decodes the settings of the policy into v, leaving it untouched when the policy has no settings.
+func (p *Policy) DecodeData(v any) error {
+	if len(p.Data) == 0 || string(p.Data) == "null" {
+		return nil
+	}
+
+	return json.Unmarshal(p.Data, v)
+}
+
+// EncodeData sets the settings of the policy from v, e.g. a MasterPasswordPolicyData. A nil v clears them.
+func (p *Policy) EncodeData(v any) error {
+	if v == nil {
+		p.Data = nil
+		return nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	p.Data = data
+
+	return nil
+}
+
+// MasterPasswordPolicyData holds the settings of PolicyMasterPassword.
+type MasterPasswordPolicyData struct {
+	// MinComplexity is the minimum zxcvbn score, from 0 (weak) to 4 (strong).
+	MinComplexity  *int64 `json:"minComplexity"`
+	MinLength      *int64 `json:"minLength"`
+	RequireUpper   bool   `json:"requireUpper"`
+	RequireLower   bool   `json:"requireLower"`
+	RequireNumbers bool   `json:"requireNumbers"`
+	RequireSpecial bool   `json:"requireSpecial"`
+	EnforceOnLogin bool   `json:"enforceOnLogin"`
+}
+
+// PasswordGeneratorPolicyData holds the settings of PolicyPasswordGenerator.
+type PasswordGeneratorPolicyData struct {
+	// OverridePasswordType forces the generator to "password" or "passphrase", empty lets the user choose.
+	OverridePasswordType string `json:"overridePasswordType,omitempty"`
+	MinLength            *int64 `json:"minLength"`
+	UseUpper             bool   `json:"useUpper"`
+	UseLower             bool   `json:"useLower"`
+	UseNumbers           bool   `json:"useNumbers"`
+	UseSpecial           bool   `json:"useSpecial"`
+	MinNumbers           *int64 `json:"minNumbers"`
+	MinSpecial           *int64 `json:"minSpecial"`
+	MinNumberWords       *int64 `json:"minNumberWords"`
+	Capitalize           bool   `json:"capitalize"`
+	IncludeNumber        bool   `json:"includeNumber"`
+}
+
+// SendOptionsPolicyData holds the settings of PolicySendOptions.
+type SendOptionsPolicyData struct {
+	DisableHideEmail bool `json:"disableHideEmail"`
+}
+
+// ResetPasswordPolicyData holds the settings of PolicyResetPassword.
+type ResetPasswordPolicyData struct {
+	AutoEnrollEnabled bool `json:"autoEnrollEnabled"`
+}
+
+// MaximumVaultTimeoutPolicyData holds the settings of PolicyMaximumVaultTimeout.
+type MaximumVaultTimeoutPolicyData struct {
+	Minutes *int64 `json:"minutes"`
+	// Action enforces the vault timeout action, "lock" or "logOut", empty lets the user choose.
+	Action string `json:"action,omitempty"`
+}
+
+func (c *client) GetPolicy(ctx context.Context, policyType PolicyType) (*Policy, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/policies/%d", c.apiURL, policyType), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := Policy{}
+	err = json.Unmarshal(body, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// ListPolicies lists the policies of the organization. Policy types that were never configured are omitted.
+func (c *client) ListPolicies(ctx context.Context) *Iterator[Policy] {
+	return newIterator[Policy](ctx, c, "/policies", nil)
+}
+
+// UpdatePolicy enables or disables the policy of policy.Type and replaces its settings. The API creates the policy on
+// its first update and policies can't be deleted, only disabled.
+func (c *client) UpdatePolicy(ctx context.Context, policy Policy) (*Policy, error) {
+	rb, err := json.Marshal(struct {
+		Enabled bool            `json:"enabled"`
+		Data    json.RawMessage `json:"data"`
+	}{policy.Enabled, policy.Data})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/policies/%d", c.apiURL, policy.Type), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	newPolicy := Policy{}
+	err = json.Unmarshal(body, &newPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newPolicy, nil
+}
@@ -0,0 +1,103 @@
+package bitwarden
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryConfig controls how the client retries requests that failed with a transient error.
+type RetryConfig struct {
+	// MaxRetries is the number of times a request is retried after the first attempt, 0 disables retrying.
+	MaxRetries int
+	// BaseBackoff is the wait before the first retry, it doubles with every subsequent retry.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the wait between two attempts, including waits requested by a Retry-After header.
+	MaxBackoff time.Duration
+	// Jitter randomly shortens every wait by up to this fraction, between 0 and 1, to spread out concurrent retries.
+	Jitter float64
+}
+
+// DefaultRetryConfig is used by NewClient unless overridden with WithRetry.
+var DefaultRetryConfig = RetryConfig{
+	MaxRetries:  3,
+	BaseBackoff: 500 * time.Millisecond,
+	MaxBackoff:  30 * time.Second,
+	Jitter:      0.2,
+}
+
+// shouldRetry reports whether a request with the given method that failed with err can safely be sent again.
+// Rate limited requests were rejected before being processed and are always retried, other transient failures only
+// for idempotent methods, as the first attempt may already have been applied.
+func (r RetryConfig) shouldRetry(method string, err error) bool {
+	var throttleErr *throttleError
+	if errors.As(err, &throttleErr) {
+		// The rate limit already determined that the request can't be sent before the deadline
+		return false
+	}
+
+	var tokenErr *TokenError
+	if errors.As(err, &tokenErr) {
+		// Obtaining a token is always safe to retry, unless the credentials are wrong
+		return !tokenErr.isPermanent()
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		// Transport failures, such as a reset connection
+		return isIdempotent(method)
+	}
+
+	switch apiErr.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	default:
+		return false
+	}
+}
+
+// backoff returns the wait before the given retry, starting at 0. A wait requested by the server takes precedence.
+func (r RetryConfig) backoff(retry int, retryAfter time.Duration) time.Duration {
+	wait := retryAfter
+	if wait <= 0 {
+		wait = time.Duration(float64(r.BaseBackoff) * math.Pow(2, float64(retry)))
+		wait -= time.Duration(float64(wait) * r.Jitter * rand.Float64())
+	}
+
+	if r.MaxBackoff > 0 && (wait > r.MaxBackoff || wait < 0) {
+		wait = r.MaxBackoff
+	}
+
+	return wait
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseRetryAfter parses the Retry-After header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+
+	return 0
+}
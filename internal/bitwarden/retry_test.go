@@ -0,0 +1,100 @@
+package bitwarden
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestServer serves a token endpoint at /token and hands every other request to handler.
+func newTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+	})
+	mux.HandleFunc("/", handler)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newTestClient(t *testing.T, server *httptest.Server, opts ...Option) Client {
+	t.Helper()
+
+	opts = append([]Option{WithRetry(RetryConfig{MaxRetries: 2, BaseBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond})}, opts...)
+	c, err := NewClient(context.Background(), "id", "secret", server.URL, server.URL+"/token", opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
+
+func TestRetryTransientFailure(t *testing.T) {
+	var calls int32
+	server := newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"group-id","name":"group"}`))
+	})
+
+	group, err := newTestClient(t, server).UpdateGroup(context.Background(), "group-id", Group{Name: "group"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if group.ID != "group-id" || calls != 2 {
+		t.Fatalf("expected the group after 2 calls, got %+v after %d calls", group, calls)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	var calls int32
+	server := newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, err := newTestClient(t, server).GetGroup(context.Background(), "group-id")
+	if !IsRateLimited(err) || calls != 3 {
+		t.Fatalf("expected rate limit error after 3 calls, got %v after %d calls", err, calls)
+	}
+}
+
+func TestRetrySkipsNonIdempotentRequests(t *testing.T) {
+	var calls int32
+	server := newTestServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, err := newTestClient(t, server).CreateGroup(context.Background(), Group{Name: "group"})
+	if err == nil || calls != 1 {
+		t.Fatalf("expected a single failed call, got %v after %d calls", err, calls)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	retry := RetryConfig{BaseBackoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	for i, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second} {
+		if wait := retry.backoff(i, 0); wait != expected {
+			t.Errorf("retry %d: expected %s, got %s", i, expected, wait)
+		}
+	}
+
+	if wait := retry.backoff(0, time.Minute); wait != 5*time.Second {
+		t.Errorf("expected Retry-After to be capped, got %s", wait)
+	}
+}
@@ -0,0 +1,19 @@
+package bitwarden
+
+import (
+	"net/http"
+)
+
+// userAgentTransport sets the User-Agent header on every request before handing it to the next transport.
+type userAgentTransport struct {
+	userAgent string
+	next      http.RoundTripper
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request it was given
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", t.userAgent)
+
+	return t.next.RoundTrip(req)
+}
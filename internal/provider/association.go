@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// associationModel maps a bitwarden.AssociationWithPermissions to a Terraform object.
+type associationModel struct {
+	ID            types.String `tfsdk:"id"`
+	ReadOnly      types.Bool   `tfsdk:"read_only"`
+	HidePasswords types.Bool   `tfsdk:"hide_passwords"`
+	Manage        types.Bool   `tfsdk:"manage"`
+}
+
+var associationAttrTypes = map[string]attr.Type{
+	"id":             types.StringType,
+	"read_only":      types.BoolType,
+	"hide_passwords": types.BoolType,
+	"manage":         types.BoolType,
+}
+
+// emptyAssociationSet is an empty set of associations.
+var emptyAssociationSet = types.SetValueMust(types.ObjectType{AttrTypes: associationAttrTypes}, []attr.Value{})
+
+// associationSetAttribute returns an optional set of associations. When it isn't configured, the associations are left
+// unmanaged and the attribute reports the ones found in Bitwarden, use associationsConfigured to tell both apart.
+func associationSetAttribute(description, idDescription string) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		Computed:    true,
+		Optional:    true,
+		Description: description,
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Required:    true,
+					Description: idDescription,
+				},
+				"read_only": schema.BoolAttribute{
+					Computed:    true,
+					Optional:    true,
+					Description: "Prevents editing the items within the collection",
+					Default:     booldefault.StaticBool(false),
+				},
+				"hide_passwords": schema.BoolAttribute{
+					Computed:    true,
+					Optional:    true,
+					Description: "Hides passwords and other hidden fields of the items within the collection",
+					Default:     booldefault.StaticBool(false),
+				},
+				"manage": schema.BoolAttribute{
+					Computed:    true,
+					Optional:    true,
+					Description: "Allows managing the collection, including its assignments",
+					Default:     booldefault.StaticBool(false),
+				},
+			},
+		},
+	}
+}
+
+// associationSetDataSourceAttribute returns the read-only counterpart of associationSetAttribute for data sources.
+func associationSetDataSourceAttribute(description, idDescription string) dschema.SetNestedAttribute {
+	return dschema.SetNestedAttribute{
+		Computed:    true,
+		Description: description,
+		NestedObject: dschema.NestedAttributeObject{
+			Attributes: map[string]dschema.Attribute{
+				"id": dschema.StringAttribute{
+					Computed:    true,
+					Description: idDescription,
+				},
+				"read_only": dschema.BoolAttribute{
+					Computed:    true,
+					Description: "Prevents editing the items within the collection",
+				},
+				"hide_passwords": dschema.BoolAttribute{
+					Computed:    true,
+					Description: "Hides passwords and other hidden fields of the items within the collection",
+				},
+				"manage": dschema.BoolAttribute{
+					Computed:    true,
+					Description: "Allows managing the collection, including its assignments",
+				},
+			},
+		},
+	}
+}
+
+// associationsConfigured reports whether the associations at p are configured, and therefore managed by the resource.
+func associationsConfigured(ctx context.Context, config tfsdk.Config, p path.Path) (bool, diag.Diagnostics) {
+	var configured types.Set
+	diags := config.GetAttribute(ctx, p, &configured)
+
+	return !configured.IsNull(), diags
+}
+
+// associationsFromSet converts a Terraform set of associations into their API representation.
+func associationsFromSet(ctx context.Context, set types.Set) ([]bitwarden.AssociationWithPermissions, diag.Diagnostics) {
+	associations := make([]bitwarden.AssociationWithPermissions, 0)
+	if set.IsNull() || set.IsUnknown() {
+		return associations, nil
+	}
+
+	var models []associationModel
+	diags := set.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, m := range models {
+		associations = append(associations, bitwarden.AssociationWithPermissions{
+			ID:            m.ID.ValueString(),
+			ReadOnly:      m.ReadOnly.ValueBool(),
+			HidePasswords: m.HidePasswords.ValueBool(),
+			Manage:        m.Manage.ValueBool(),
+		})
+	}
+
+	return associations, diags
+}
+
+// associationsToSet converts associations returned by the API into a Terraform set.
+func associationsToSet(ctx context.Context, associations []bitwarden.AssociationWithPermissions) (types.Set, diag.Diagnostics) {
+	models := make([]associationModel, 0, len(associations))
+	for _, a := range associations {
+		models = append(models, associationModel{
+			ID:            types.StringValue(a.ID),
+			ReadOnly:      types.BoolValue(a.ReadOnly),
+			HidePasswords: types.BoolValue(a.HidePasswords),
+			Manage:        types.BoolValue(a.Manage),
+		})
+	}
+
+	return types.SetValueFrom(ctx, types.ObjectType{AttrTypes: associationAttrTypes}, models)
+}
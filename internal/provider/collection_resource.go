@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &collectionResource{}
+	_ resource.ResourceWithConfigure   = &collectionResource{}
+	_ resource.ResourceWithImportState = &collectionResource{}
+)
+
+// NewCollectionResource is a helper function to simplify the provider implementation.
+func NewCollectionResource() resource.Resource {
+	return &collectionResource{}
+}
+
+// collectionResource is the resource implementation.
+type collectionResource struct {
+	client *bitwarden.Client
+}
+
+type collectionResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ExternalId  types.String `tfsdk:"external_id"`
+	Groups      types.Set    `tfsdk:"groups"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// collectionFieldPaths maps the fields of bitwarden.Collection to their attribute, to report API validation errors.
+var collectionFieldPaths = map[string]path.Path{
+	"externalId": path.Root("external_id"),
+	"groups":     path.Root("groups"),
+}
+
+// Metadata returns the resource type name.
+func (r *collectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection"
+}
+
+func (r *collectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(bitwarden.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitwarden.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &client
+}
+
+// Schema defines the schema for the resource.
+func (r *collectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Managing the group access is the purpose of this resource, so omitting groups removes every group
+	groups := associationSetAttribute(
+		"The groups that have access to this collection, together with the permissions they are granted. Omitting it removes the access of every group",
+		"The group's unique identifier within the organization",
+	)
+	groups.Default = setdefault.StaticValue(emptyAssociationSet)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Bitwarden collection resource manages the external identifier and group access of a collection within an Bitwarden organization. " +
+			"Collection names are encrypted client side, so the [Bitwarden API](https://bitwarden.com/help/api/) can't create collections: " +
+			"create the collection in the web vault first and reference its ID here. Destroying this resource doesn't delete the collection: " +
+			"it removes the group access and the external identifier, and leaves the collection itself in the organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "The collection's unique identifier within the organization",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "External identifier for reference or linking this collection to another system",
+				Default:     stringdefault.StaticString(""),
+			},
+			"groups": groups,
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Create adopts the existing collection and sets the initial Terraform state.
+func (r *collectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan collectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, diags := associationsFromSet(ctx, plan.Groups)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collection := bitwarden.Collection{
+		ExternalId: plan.ExternalId.ValueString(),
+		Groups:     groups,
+	}
+
+	// Collections can't be created through the API, so take over the existing one
+	newCollection, err := (*r.client).UpdateCollection(ctx, plan.ID.ValueString(), collection)
+	if bitwarden.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Bitwarden Collection Not Found",
+			"Collection ID "+plan.ID.ValueString()+" doesn't exist in the organization. Collections can't be created through the Bitwarden API, "+
+				"create the collection in the web vault and set id to its ID.",
+		)
+		return
+	}
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error creating collection",
+			"Could not update collection ID "+plan.ID.ValueString()+", unexpected error: ",
+			err,
+			collectionFieldPaths,
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(newCollection.ID)
+	plan.ExternalId = types.StringValue(newCollection.ExternalId)
+	plan.Groups, diags = associationsToSet(ctx, newCollection.Groups)
+	resp.Diagnostics.Append(diags...)
+	plan.LastUpdated = types.StringValue(time.Now().UTC().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *collectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state collectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed collection value from BitWarden
+	collection, err := (*r.client).GetCollection(ctx, state.ID.ValueString())
+	if bitwarden.IsNotFound(err) {
+		// The collection was deleted outside of Terraform, so drop it from state. Creating it again fails with a clear
+		// error, as collections can only be created in the web vault.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Reading Bitwarden collection",
+			"Could not read Bitwarden collection ID "+state.ID.ValueString()+": ",
+			err,
+			nil,
+		)
+		return
+	}
+
+	// Overwrite collection with refreshed state
+	state.ID = types.StringValue(collection.ID)
+	state.ExternalId = types.StringValue(collection.ExternalId)
+	state.Groups, diags = associationsToSet(ctx, collection.Groups)
+	resp.Diagnostics.Append(diags...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *collectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan collectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	groups, diags := associationsFromSet(ctx, plan.Groups)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collection := bitwarden.Collection{
+		ExternalId: plan.ExternalId.ValueString(),
+		Groups:     groups,
+	}
+
+	// Update existing collection
+	newCollection, err := (*r.client).UpdateCollection(ctx, plan.ID.ValueString(), collection)
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Updating Bitwarden collection",
+			"Could not update collection, unexpected error: ",
+			err,
+			collectionFieldPaths,
+		)
+		return
+	}
+
+	// Update resource state with updated items and timestamp
+	plan.ExternalId = types.StringValue(newCollection.ExternalId)
+	plan.Groups, diags = associationsToSet(ctx, newCollection.Groups)
+	resp.Diagnostics.Append(diags...)
+	plan.LastUpdated = types.StringValue(time.Now().UTC().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete releases the collection and removes the Terraform state on success. The collection was created in the web
+// vault rather than by Terraform, so only the settings managed by this resource are cleared.
+func (r *collectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state collectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the group access and the external ID, but keep the collection and its items
+	_, err := (*r.client).UpdateCollection(ctx, state.ID.ValueString(), bitwarden.Collection{})
+	// Nothing left to release when the collection was already removed outside of Terraform
+	if bitwarden.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Deleting Bitwarden collection",
+			"Could not remove the group access of collection ID "+state.ID.ValueString()+", unexpected error: ",
+			err,
+			nil,
+		)
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Bitwarden Collection Not Deleted",
+		"Collection ID "+state.ID.ValueString()+" was removed from Terraform and its group access and external ID were cleared, "+
+			"but the collection itself is left in the organization. Delete it in the web vault if it's no longer needed.",
+	)
+}
+
+func (r *collectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCollectionResource(t *testing.T) {
+	// Collections can't be created through the API, so the test needs an existing one
+	collectionId := os.Getenv("BITWARDEN_COLLECTION_ID")
+	if collectionId == "" {
+		t.Skip("BITWARDEN_COLLECTION_ID must be set to run the collection acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCollectionResourceConfig(collectionId, "", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bitwarden_collection.test", "id", collectionId),
+					resource.TestCheckResourceAttr("bitwarden_collection.test", "external_id", ""),
+					resource.TestCheckResourceAttr("bitwarden_collection.test", "groups.#", "1"),
+					resource.TestCheckResourceAttr("bitwarden_collection.test", "groups.0.read_only", "true"),
+					resource.TestCheckResourceAttr("bitwarden_collection.test", "groups.0.hide_passwords", "false"),
+					resource.TestCheckResourceAttrSet("bitwarden_collection.test", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "bitwarden_collection.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			//Update and Read testing
+			{
+				Config: testAccCollectionResourceConfig(collectionId, "external-two", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bitwarden_collection.test", "external_id", "external-two"),
+					resource.TestCheckResourceAttr("bitwarden_collection.test", "groups.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCollectionResourceConfig(id, externalId string, withGroup bool) string {
+	builder := strings.Builder{}
+	builder.WriteString("resource \"bitwarden_group\" \"test\" {\n")
+	builder.WriteString("name = \"collection-test\"\n")
+	builder.WriteString("}\n")
+	builder.WriteString("resource \"bitwarden_collection\" \"test\" {\n")
+	builder.WriteString(fmt.Sprintf("id = %[1]q\n", id))
+	if len(externalId) != 0 {
+		builder.WriteString(fmt.Sprintf("external_id = %[1]q\n", externalId))
+	}
+	if withGroup {
+		builder.WriteString("groups = [{ id = bitwarden_group.test.id, read_only = true }]\n")
+	}
+	builder.WriteString("}")
+
+	return builder.String()
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &collectionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &collectionsDataSource{}
+)
+
+// NewCollectionsDataSource is a helper function to simplify the provider implementation.
+func NewCollectionsDataSource() datasource.DataSource {
+	return &collectionsDataSource{}
+}
+
+// collectionsDataSource is the data source implementation.
+type collectionsDataSource struct {
+	client *bitwarden.Client
+}
+
+type collectionsDataSourceModel struct {
+	ExternalId       types.String          `tfsdk:"external_id"`
+	ExternalIdPrefix types.String          `tfsdk:"external_id_prefix"`
+	ExternalIdRegex  types.String          `tfsdk:"external_id_regex"`
+	Collections      []collectionItemModel `tfsdk:"collections"`
+}
+
+// collectionItemModel is a single collection as listed by the Public API, which doesn't include their groups.
+type collectionItemModel struct {
+	ID         types.String `tfsdk:"id"`
+	ExternalId types.String `tfsdk:"external_id"`
+}
+
+// Metadata returns the data source type name.
+func (d *collectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collections"
+}
+
+func (d *collectionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(bitwarden.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitwarden.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = &client
+}
+
+// Schema defines the schema for the data source.
+func (d *collectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the collections of the organization, optionally filtered by external ID. All filters must match for a collection to be listed. " +
+			"Collection names are encrypted with the organization key and therefore not available through the Bitwarden Public API, so they can't be filtered on.",
+		Attributes: map[string]schema.Attribute{
+			"external_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list collections with this external identifier",
+			},
+			"external_id_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list collections whose external identifier starts with this prefix",
+			},
+			"external_id_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list collections whose external identifier matches this regular expression, in the syntax of https://pkg.go.dev/regexp/syntax",
+				Validators: []validator.String{
+					regexValidator{},
+				},
+			},
+			"collections": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching collections, sorted by ID",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The collection's unique identifier within the organization",
+						},
+						"external_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "External identifier for reference or linking this collection to another system",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *collectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state collectionsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := newTextFilter(state.ExternalId, state.ExternalIdPrefix, state.ExternalIdRegex)
+
+	collections, err := findAll((*d.client).ListCollections(ctx), func(c bitwarden.Collection) bool {
+		return filter.match(c.ExternalId)
+	})
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to List Bitwarden Collections", "", err, nil)
+		return
+	}
+
+	sort.Slice(collections, func(i, j int) bool {
+		return collections[i].ID < collections[j].ID
+	})
+
+	state.Collections = make([]collectionItemModel, 0, len(collections))
+	for _, c := range collections {
+		state.Collections = append(state.Collections, collectionItemModel{
+			ID:         types.StringValue(c.ID),
+			ExternalId: types.StringValue(c.ExternalId),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
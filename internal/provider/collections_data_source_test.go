@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCollectionsDataSource(t *testing.T) {
+	// Collections can't be created through the API, so the test needs an existing one
+	collectionId := os.Getenv("BITWARDEN_COLLECTION_ID")
+	if collectionId == "" {
+		t.Skip("BITWARDEN_COLLECTION_ID must be set to run the collection acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "bitwarden_collection" "test" {
+  id          = %[1]q
+  external_id = "collections-data-source-test"
+}
+
+data "bitwarden_collections" "test" {
+  external_id_prefix = "collections-data-source-"
+
+  depends_on = [bitwarden_collection.test]
+}
+`, collectionId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bitwarden_collections.test", "collections.#", "1"),
+					resource.TestCheckResourceAttr("data.bitwarden_collections.test", "collections.0.id", collectionId),
+				),
+			},
+		},
+	})
+}
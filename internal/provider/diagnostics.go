@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// addAPIError adds a diagnostic for an error returned by the Bitwarden client, with the error appended to detail.
+// Validation errors the API reports for a request field are attached to the matching attribute in fields instead,
+// which is keyed by the API field name, e.g. "externalId".
+func addAPIError(diags *diag.Diagnostics, summary, detail string, err error, fields map[string]path.Path) {
+	var tokenErr *bitwarden.TokenError
+	if errors.As(err, &tokenErr) {
+		diags.AddError(
+			"Unable to Authenticate with Bitwarden",
+			"The provider could not obtain an access token from the Bitwarden identity endpoint. "+
+				"Ensure client_id and client_secret hold the API key of your organization, and that authentication_url points at the "+
+				"identity endpoint of your Bitwarden server, such as https://identity.bitwarden.com/connect/token.\n\n"+
+				"Bitwarden Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	var apiErr *bitwarden.APIError
+	if !errors.As(err, &apiErr) {
+		diags.AddError(summary, detail+err.Error())
+		return
+	}
+
+	if bitwarden.IsUnauthorized(err) {
+		diags.AddError(summary, detail+err.Error()+"\n\n"+
+			"Bitwarden rejected the credentials of the provider, ensure client_id and client_secret belong to the organization API key.")
+		return
+	}
+
+	// Sort the fields, so that the diagnostics are reported in the same order on every run
+	names := make([]string, 0, len(apiErr.Errors))
+	for field := range apiErr.Errors {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+
+	unmatched := false
+	for _, field := range names {
+		attributePath, ok := fieldPath(fields, field)
+		if !ok {
+			unmatched = true
+			continue
+		}
+
+		diags.AddAttributeError(attributePath, summary, detail+apiErr.Message+" "+strings.Join(apiErr.Errors[field], " "))
+	}
+
+	if unmatched || len(apiErr.Errors) == 0 {
+		diags.AddError(summary, detail+err.Error())
+	}
+}
+
+// fieldPath finds the attribute for an API field name. Nested fields such as "Collections[0].Id" resolve to the
+// attribute of their top level field, and the comparison ignores case as the API isn't consistent about it.
+func fieldPath(fields map[string]path.Path, field string) (path.Path, bool) {
+	if i := strings.IndexAny(field, "[."); i >= 0 {
+		field = field[:i]
+	}
+
+	for name, attributePath := range fields {
+		if strings.EqualFold(name, field) {
+			return attributePath, true
+		}
+	}
+
+	return path.Empty(), false
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+func TestAddAPIErrorAttachesFieldErrors(t *testing.T) {
+	err := &bitwarden.APIError{
+		StatusCode: 400,
+		Message:    "The model state is invalid.",
+		Errors: map[string][]string{
+			"Collections[0].Id": {"The Id field is required."},
+		},
+	}
+
+	var diags diag.Diagnostics
+	addAPIError(&diags, "summary", "detail: ", err, memberFieldPaths)
+
+	if len(diags) != 1 {
+		t.Fatalf("expected a single diagnostic, got %v", diags)
+	}
+
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(path.Root("collections")) {
+		t.Fatalf("expected diagnostic for the collections attribute, got %v", diags[0])
+	}
+}
+
+func TestAddAPIErrorSortsFieldErrors(t *testing.T) {
+	err := &bitwarden.APIError{
+		StatusCode: 400,
+		Message:    "The model state is invalid.",
+		Errors: map[string][]string{
+			"Type":       {"The Type field is required."},
+			"Email":      {"The Email field is required."},
+			"ExternalId": {"The field ExternalId must be a string with a maximum length of 300."},
+		},
+	}
+
+	// Map iteration order is random, so repeat to catch an unsorted implementation
+	for i := 0; i < 10; i++ {
+		var diags diag.Diagnostics
+		addAPIError(&diags, "summary", "detail: ", err, memberFieldPaths)
+
+		if len(diags) != 3 {
+			t.Fatalf("expected a diagnostic per field, got %v", diags)
+		}
+		for j, expected := range []path.Path{path.Root("email"), path.Root("external_id"), path.Root("type")} {
+			if withPath, ok := diags[j].(diag.DiagnosticWithPath); !ok || !withPath.Path().Equal(expected) {
+				t.Fatalf("expected diagnostic %d for %s, got %v", j, expected, diags[j])
+			}
+		}
+	}
+}
+
+func TestAddAPIErrorWithoutFieldErrors(t *testing.T) {
+	var diags diag.Diagnostics
+	addAPIError(&diags, "summary", "detail: ", errors.New("boom"), memberFieldPaths)
+
+	if len(diags) != 1 || diags[0].Detail() != "detail: boom" {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+}
+
+func TestAddAPIErrorForTokenError(t *testing.T) {
+	var diags diag.Diagnostics
+	addAPIError(&diags, "summary", "detail: ", &bitwarden.TokenError{Err: errors.New("invalid_client")}, nil)
+
+	if len(diags) != 1 || diags[0].Summary() != "Unable to Authenticate with Bitwarden" {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+}
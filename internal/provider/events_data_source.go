@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &eventsDataSource{}
+	_ datasource.DataSourceWithConfigure = &eventsDataSource{}
+)
+
+// NewEventsDataSource is a helper function to simplify the provider implementation.
+func NewEventsDataSource() datasource.DataSource {
+	return &eventsDataSource{}
+}
+
+// eventsDataSource is the data source implementation.
+type eventsDataSource struct {
+	client *bitwarden.Client
+}
+
+type eventsDataSourceModel struct {
+	Start        types.String     `tfsdk:"start"`
+	End          types.String     `tfsdk:"end"`
+	ActingUserID types.String     `tfsdk:"acting_user_id"`
+	ItemID       types.String     `tfsdk:"item_id"`
+	Types        []types.String   `tfsdk:"types"`
+	Limit        types.Int64      `tfsdk:"limit"`
+	Events       []eventItemModel `tfsdk:"events"`
+}
+
+// eventItemModel is a single entry of the event logs.
+type eventItemModel struct {
+	Type         types.String `tfsdk:"type"`
+	Date         types.String `tfsdk:"date"`
+	ActingUserID types.String `tfsdk:"acting_user_id"`
+	MemberID     types.String `tfsdk:"member_id"`
+	GroupID      types.String `tfsdk:"group_id"`
+	CollectionID types.String `tfsdk:"collection_id"`
+	PolicyID     types.String `tfsdk:"policy_id"`
+	ItemID       types.String `tfsdk:"item_id"`
+	IPAddress    types.String `tfsdk:"ip_address"`
+	Device       types.String `tfsdk:"device"`
+}
+
+// eventTypeNames returns the sorted names of the event types known to the client.
+func eventTypeNames() []string {
+	names := make([]string, 0, len(bitwarden.EventTypes()))
+	for _, t := range bitwarden.EventTypes() {
+		names = append(names, t.String())
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Metadata returns the data source type name.
+func (d *eventsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_events"
+}
+
+func (d *eventsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(bitwarden.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitwarden.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = &client
+}
+
+// Schema defines the schema for the data source.
+func (d *eventsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the event logs of the organization, from the most recent to the oldest. " +
+			"Without start and end, Bitwarden returns the events of the last 30 days.",
+		Attributes: map[string]schema.Attribute{
+			"start": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list events that occurred at or after this RFC 3339 timestamp, e.g. 2024-01-02T15:04:05Z",
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"end": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list events that occurred at or before this RFC 3339 timestamp",
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"acting_user_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list events triggered by the user with this ID",
+			},
+			"item_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list events about the vault item with this ID",
+			},
+			"types": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Only list events of these types, e.g. group_created or policy_updated",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(eventTypeNames()...)),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of events to list, the most recent first. Set it to avoid reading the full event logs on every plan",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"events": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching events, the most recent first",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The event type, e.g. group_created, or its number for event types unknown to the provider",
+						},
+						"date": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the event occurred, as RFC 3339 timestamp",
+						},
+						"acting_user_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the user who triggered the event",
+						},
+						"member_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the member the event is about",
+						},
+						"group_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the group the event is about",
+						},
+						"collection_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the collection the event is about",
+						},
+						"policy_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the policy the event is about",
+						},
+						"item_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the vault item the event is about",
+						},
+						"ip_address": schema.StringAttribute{
+							Computed:    true,
+							Description: "The IP address the event originated from",
+						},
+						"device": schema.StringAttribute{
+							Computed:    true,
+							Description: "The client the event originated from, e.g. chrome_extension",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *eventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state eventsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := bitwarden.EventFilter{
+		ActingUserID: state.ActingUserID.ValueString(),
+		ItemID:       state.ItemID.ValueString(),
+	}
+	// Both timestamps are already validated by rfc3339Validator
+	if !state.Start.IsNull() {
+		filter.Start, _ = time.Parse(time.RFC3339, state.Start.ValueString())
+	}
+	if !state.End.IsNull() {
+		filter.End, _ = time.Parse(time.RFC3339, state.End.ValueString())
+	}
+
+	eventTypes := make(map[string]bool, len(state.Types))
+	for _, t := range state.Types {
+		eventTypes[t.ValueString()] = true
+	}
+
+	state.Events = make([]eventItemModel, 0)
+	it := (*d.client).GetEvents(ctx, filter)
+	for (state.Limit.IsNull() || int64(len(state.Events)) < state.Limit.ValueInt64()) && it.Next() {
+		event := it.Value()
+		if len(eventTypes) != 0 && !eventTypes[event.Type.String()] {
+			continue
+		}
+
+		state.Events = append(state.Events, eventItemFromEvent(event))
+	}
+	if err := it.Err(); err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to List Bitwarden Events", "", err, nil)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// eventItemFromEvent maps an event to its Terraform model, identifiers that don't apply to the event type are null.
+func eventItemFromEvent(event bitwarden.Event) eventItemModel {
+	optional := func(value string) types.String {
+		if value == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(value)
+	}
+
+	item := eventItemModel{
+		Type:         types.StringValue(event.Type.String()),
+		Date:         types.StringValue(event.Date.UTC().Format(time.RFC3339)),
+		ActingUserID: optional(event.ActingUserID),
+		MemberID:     optional(event.MemberID),
+		GroupID:      optional(event.GroupID),
+		CollectionID: optional(event.CollectionID),
+		PolicyID:     optional(event.PolicyID),
+		ItemID:       optional(event.ItemID),
+		IPAddress:    optional(event.IPAddress),
+		Device:       types.StringNull(),
+	}
+	if event.Device != nil {
+		item.Device = types.StringValue(event.Device.String())
+	}
+
+	return item
+}
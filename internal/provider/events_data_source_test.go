@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+func TestAccEventsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "bitwarden_events" "test" {
+  types = ["group_created", "group_updated", "group_deleted"]
+  limit = 5
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.bitwarden_events.test", "events.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestEventItemFromEvent(t *testing.T) {
+	device := bitwarden.DeviceType(2)
+	item := eventItemFromEvent(bitwarden.Event{
+		Type:         bitwarden.EventGroupUpdated,
+		Date:         time.Date(2024, 1, 2, 16, 4, 5, 0, time.FixedZone("CET", 3600)),
+		ActingUserID: "user",
+		GroupID:      "group",
+		Device:       &device,
+	})
+
+	if item.Type.ValueString() != "group_updated" || item.Date.ValueString() != "2024-01-02T15:04:05Z" ||
+		item.GroupID.ValueString() != "group" || item.Device.ValueString() != "chrome_extension" {
+		t.Errorf("unexpected item %+v", item)
+	}
+	if !item.MemberID.IsNull() || !item.ItemID.IsNull() || !item.IPAddress.IsNull() {
+		t.Errorf("expected the identifiers that don't apply to be null, got %+v", item)
+	}
+}
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &groupDataSource{}
+	_ datasource.DataSourceWithConfigure        = &groupDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &groupDataSource{}
+)
+
+// NewGroupDataSource is a helper function to simplify the provider implementation.
+func NewGroupDataSource() datasource.DataSource {
+	return &groupDataSource{}
+}
+
+// groupDataSource is the data source implementation.
+type groupDataSource struct {
+	client *bitwarden.Client
+}
+
+type groupDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	ExternalId  types.String `tfsdk:"external_id"`
+	AccessAll   types.Bool   `tfsdk:"access_all"`
+	Collections types.Set    `tfsdk:"collections"`
+	MemberIDs   types.Set    `tfsdk:"member_ids"`
+}
+
+// Metadata returns the data source type name.
+func (d *groupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (d *groupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(bitwarden.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitwarden.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = &client
+}
+
+// Schema defines the schema for the data source.
+func (d *groupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing group of the organization by its ID, name or external ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The group's unique identifier within the organization",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The name of the group, must match exactly. Fails when several groups share the name",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "External identifier for reference or linking this group to another system, such as a user directory",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"access_all": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Determines if the members of this group can access all collections within the organization",
+			},
+			"collections": associationSetDataSourceAttribute(
+				"The collections the members of this group have access to, together with the permissions they are granted",
+				"The collection's unique identifier within the organization",
+			),
+			"member_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The unique identifiers of the members of this group",
+			},
+		},
+	}
+}
+
+func (d *groupDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("external_id"),
+		),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config groupDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := config.ID.ValueString()
+	if id == "" {
+		// The Public API can't filter groups, so search the whole list
+		attribute, value := "name", config.Name.ValueString()
+		if config.Name.IsNull() {
+			attribute, value = "external_id", config.ExternalId.ValueString()
+		}
+		match, description := groupMatcher(attribute, value)
+
+		matches, err := findAll((*d.client).ListGroups(ctx), match)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Unable to List Bitwarden Groups", "", err, nil)
+			return
+		}
+
+		group, err := uniqueMatch(matches, func(g bitwarden.Group) string { return g.ID }, description)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attribute), "Unable to Find Bitwarden Group", err.Error())
+			return
+		}
+		id = group.ID
+	}
+
+	// The list omits the collections of the groups, so always get the group itself
+	group, err := (*d.client).GetGroup(ctx, id)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to Read Bitwarden Group", "Could not read Bitwarden group ID "+id+": ", err, nil)
+		return
+	}
+
+	memberIDs, err := (*d.client).GetGroupMemberIDs(ctx, id)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to Read Bitwarden Group Members", "Could not read the members of Bitwarden group ID "+id+": ", err, nil)
+		return
+	}
+
+	state := groupDataSourceModel{
+		ID:         types.StringValue(group.ID),
+		Name:       types.StringValue(group.Name),
+		ExternalId: types.StringValue(group.ExternalId),
+		AccessAll:  types.BoolValue(group.AccessAll),
+	}
+	state.Collections, diags = associationsToSet(ctx, group.Collections)
+	resp.Diagnostics.Append(diags...)
+	state.MemberIDs, diags = types.SetValueFrom(ctx, types.StringType, memberIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
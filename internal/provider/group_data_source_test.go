@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGroupDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read by name testing
+			{
+				Config: testAccGroupDataSourceConfig(`name = bitwarden_group.test.name`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.bitwarden_group.test", "id", "bitwarden_group.test", "id"),
+					resource.TestCheckResourceAttr("data.bitwarden_group.test", "external_id", "group-data-source-test"),
+					resource.TestCheckResourceAttr("data.bitwarden_group.test", "access_all", "true"),
+					resource.TestCheckResourceAttr("data.bitwarden_group.test", "member_ids.#", "0"),
+				),
+			},
+			// Read by external ID testing
+			{
+				Config: testAccGroupDataSourceConfig(`external_id = bitwarden_group.test.external_id`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.bitwarden_group.test", "id", "bitwarden_group.test", "id"),
+					resource.TestCheckResourceAttr("data.bitwarden_group.test", "name", "group-data-source-test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGroupDataSourceConfig(lookup string) string {
+	return fmt.Sprintf(`
+resource "bitwarden_group" "test" {
+  name        = "group-data-source-test"
+  external_id = "group-data-source-test"
+  access_all  = true
+}
+
+data "bitwarden_group" "test" {
+  %s
+}
+`, lookup)
+}
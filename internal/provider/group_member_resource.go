@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &groupMemberResource{}
+	_ resource.ResourceWithConfigure   = &groupMemberResource{}
+	_ resource.ResourceWithImportState = &groupMemberResource{}
+)
+
+// NewGroupMemberResource is a helper function to simplify the provider implementation.
+func NewGroupMemberResource() resource.Resource {
+	return &groupMemberResource{}
+}
+
+// groupMemberResource is the resource implementation.
+type groupMemberResource struct {
+	client *bitwarden.Client
+}
+
+type groupMemberResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	GroupID     types.String `tfsdk:"group_id"`
+	MemberID    types.String `tfsdk:"member_id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// Metadata returns the resource type name.
+func (r *groupMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_member"
+}
+
+func (r *groupMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(bitwarden.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitwarden.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &client
+}
+
+// Schema defines the schema for the resource.
+func (r *groupMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Bitwarden group member resource adds a single member to a Bitwarden group, leaving the other members of the group untouched. " +
+			"Don't combine it with `bitwarden_group_members` for the same group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the group membership, formatted as `<group_id>/<member_id>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The group's unique identifier within the organization",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The member's unique identifier within the organization",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *groupMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan groupMemberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := plan.GroupID.ValueString()
+	memberID := plan.MemberID.ValueString()
+
+	groupMembershipMutex.Lock(groupID)
+	defer groupMembershipMutex.Unlock(groupID)
+
+	memberIDs, err := (*r.client).GetGroupMemberIDs(ctx, groupID)
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error creating group member",
+			"Could not read members of group ID "+groupID+", unexpected error: ",
+			err,
+			nil,
+		)
+		return
+	}
+
+	// Add the member to the group, unless it's already part of it
+	if !containsID(memberIDs, memberID) {
+		err = (*r.client).UpdateGroupMemberIDs(ctx, groupID, append(memberIDs, memberID))
+		if err != nil {
+			addAPIError(
+				&resp.Diagnostics,
+				"Error creating group member",
+				"Could not add member ID "+memberID+" to group ID "+groupID+", unexpected error: ",
+				err,
+				nil,
+			)
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(groupID + "/" + memberID)
+	plan.LastUpdated = types.StringValue(time.Now().UTC().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *groupMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state groupMemberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed member IDs from BitWarden
+	memberIDs, err := (*r.client).GetGroupMemberIDs(ctx, state.GroupID.ValueString())
+	if bitwarden.IsNotFound(err) {
+		// The group was deleted outside of Terraform, so the member is no longer part of it
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Reading Bitwarden group member",
+			"Could not read members of Bitwarden group ID "+state.GroupID.ValueString()+": ",
+			err,
+			nil,
+		)
+		return
+	}
+
+	// The member was removed from the group outside of Terraform
+	if !containsID(memberIDs, state.MemberID.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+}
+
+// Update is never called, as every attribute requires the resource to be replaced.
+func (r *groupMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan groupMemberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the member from the group and removes the Terraform state on success.
+func (r *groupMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state groupMemberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := state.GroupID.ValueString()
+	memberID := state.MemberID.ValueString()
+
+	groupMembershipMutex.Lock(groupID)
+	defer groupMembershipMutex.Unlock(groupID)
+
+	memberIDs, err := (*r.client).GetGroupMemberIDs(ctx, groupID)
+	if bitwarden.IsNotFound(err) {
+		// The group itself is gone, so the member is no longer part of it
+		return
+	}
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Deleting Bitwarden group member",
+			"Could not read members of group ID "+groupID+", unexpected error: ",
+			err,
+			nil,
+		)
+		return
+	}
+
+	if !containsID(memberIDs, memberID) {
+		return
+	}
+
+	// Remove the member from the group, keeping all other members
+	remaining := make([]string, 0, len(memberIDs))
+	for _, id := range memberIDs {
+		if !strings.EqualFold(id, memberID) {
+			remaining = append(remaining, id)
+		}
+	}
+
+	err = (*r.client).UpdateGroupMemberIDs(ctx, groupID, remaining)
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Deleting Bitwarden group member",
+			"Could not remove member ID "+memberID+" from group ID "+groupID+", unexpected error: ",
+			err,
+			nil,
+		)
+		return
+	}
+}
+
+func (r *groupMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupID, memberID, found := strings.Cut(req.ID, "/")
+	if !found || groupID == "" || memberID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <group_id>/<member_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_id"), memberID)...)
+}
+
+// containsID reports whether ids contains id. Bitwarden IDs are GUIDs, so the comparison ignores case.
+func containsID(ids []string, id string) bool {
+	for _, i := range ids {
+		if strings.EqualFold(i, id) {
+			return true
+		}
+	}
+
+	return false
+}
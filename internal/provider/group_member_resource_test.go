@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGroupMemberResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGroupMemberResourceConfig("one@fake.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("bitwarden_group_member.test", "group_id", "bitwarden_group.test", "id"),
+					resource.TestCheckResourceAttrPair("bitwarden_group_member.test", "member_id", "bitwarden_member.test", "id"),
+					resource.TestCheckResourceAttrSet("bitwarden_group_member.test", "id"),
+					resource.TestCheckResourceAttrSet("bitwarden_group_member.test", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "bitwarden_group_member.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Replace testing
+			{
+				Config: testAccGroupMemberResourceConfig("two@fake.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("bitwarden_group_member.test", "member_id", "bitwarden_member.test", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccGroupMemberResourceConfig(email string) string {
+	builder := strings.Builder{}
+	builder.WriteString("resource \"bitwarden_group\" \"test\" {\n")
+	builder.WriteString("name = \"group-member-test\"\n")
+	builder.WriteString("}\n")
+	builder.WriteString("resource \"bitwarden_member\" \"test\" {\n")
+	builder.WriteString("type = 2\n")
+	builder.WriteString(fmt.Sprintf("email = %[1]q\n", email))
+	builder.WriteString("}\n")
+	builder.WriteString("resource \"bitwarden_group_member\" \"test\" {\n")
+	builder.WriteString("group_id = bitwarden_group.test.id\n")
+	builder.WriteString("member_id = bitwarden_member.test.id\n")
+	builder.WriteString("}")
+
+	return builder.String()
+}
@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &groupMembersResource{}
+	_ resource.ResourceWithConfigure   = &groupMembersResource{}
+	_ resource.ResourceWithImportState = &groupMembersResource{}
+)
+
+// NewGroupMembersResource is a helper function to simplify the provider implementation.
+func NewGroupMembersResource() resource.Resource {
+	return &groupMembersResource{}
+}
+
+// groupMembersResource is the resource implementation.
+type groupMembersResource struct {
+	client *bitwarden.Client
+}
+
+type groupMembersResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	GroupID     types.String `tfsdk:"group_id"`
+	MemberIDs   types.Set    `tfsdk:"member_ids"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// Metadata returns the resource type name.
+func (r *groupMembersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_members"
+}
+
+func (r *groupMembersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(bitwarden.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitwarden.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &client
+}
+
+// Schema defines the schema for the resource.
+func (r *groupMembersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Bitwarden group members resource authoritatively manages all members of a Bitwarden group: " +
+			"members added outside of Terraform are removed on the next apply. Don't combine it with `bitwarden_group_member` for the same group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the group, same as group_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The group's unique identifier within the organization",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The unique identifiers of all members of the group",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *groupMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan groupMembersResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var memberIDs []string
+	diags = plan.MemberIDs.ElementsAs(ctx, &memberIDs, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupMembershipMutex.Lock(plan.GroupID.ValueString())
+	defer groupMembershipMutex.Unlock(plan.GroupID.ValueString())
+
+	// Replace the members of the group
+	err := (*r.client).UpdateGroupMemberIDs(ctx, plan.GroupID.ValueString(), memberIDs)
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error creating group members",
+			"Could not update members of group ID "+plan.GroupID.ValueString()+", unexpected error: ",
+			err,
+			nil,
+		)
+		return
+	}
+
+	plan.ID = plan.GroupID
+	plan.LastUpdated = types.StringValue(time.Now().UTC().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *groupMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state groupMembersResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed member IDs from BitWarden
+	memberIDs, err := (*r.client).GetGroupMemberIDs(ctx, state.ID.ValueString())
+	if bitwarden.IsNotFound(err) {
+		// The group was deleted outside of Terraform, so drop its members from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Reading Bitwarden group members",
+			"Could not read members of Bitwarden group ID "+state.ID.ValueString()+": ",
+			err,
+			nil,
+		)
+		return
+	}
+
+	var knownIDs []string
+	diags = state.MemberIDs.ElementsAs(ctx, &knownIDs, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Overwrite group members with refreshed state
+	state.GroupID = state.ID
+	state.MemberIDs, diags = types.SetValueFrom(ctx, types.StringType, preferKnownIDs(memberIDs, knownIDs))
+	resp.Diagnostics.Append(diags...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *groupMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan groupMembersResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var memberIDs []string
+	diags = plan.MemberIDs.ElementsAs(ctx, &memberIDs, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupMembershipMutex.Lock(plan.GroupID.ValueString())
+	defer groupMembershipMutex.Unlock(plan.GroupID.ValueString())
+
+	// Replace the members of the group
+	err := (*r.client).UpdateGroupMemberIDs(ctx, plan.GroupID.ValueString(), memberIDs)
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Updating Bitwarden group members",
+			"Could not update group members, unexpected error: ",
+			err,
+			nil,
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().UTC().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes all members from the group and removes the Terraform state on success.
+func (r *groupMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state groupMembersResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupMembershipMutex.Lock(state.GroupID.ValueString())
+	defer groupMembershipMutex.Unlock(state.GroupID.ValueString())
+
+	// Remove all members from the group
+	err := (*r.client).UpdateGroupMemberIDs(ctx, state.GroupID.ValueString(), nil)
+	// Nothing left to delete when the group was already removed outside of Terraform
+	if err != nil && !bitwarden.IsNotFound(err) {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Deleting Bitwarden group members",
+			"Could not delete group members, unexpected error: ",
+			err,
+			nil,
+		)
+		return
+	}
+}
+
+func (r *groupMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// preferKnownIDs returns ids, spelled like the IDs of known that only differ in case. Bitwarden compares IDs
+// case-insensitively, so keeping the configured spelling avoids a perpetual diff.
+func preferKnownIDs(ids, known []string) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		for _, k := range known {
+			if strings.EqualFold(id, k) {
+				id = k
+				break
+			}
+		}
+		result = append(result, id)
+	}
+
+	return result
+}
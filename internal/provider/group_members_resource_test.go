@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGroupMembersResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGroupMembersResourceConfig("one@fake.com", "two@fake.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("bitwarden_group_members.test", "group_id", "bitwarden_group.test", "id"),
+					resource.TestCheckResourceAttrPair("bitwarden_group_members.test", "id", "bitwarden_group.test", "id"),
+					resource.TestCheckResourceAttr("bitwarden_group_members.test", "member_ids.#", "2"),
+					resource.TestCheckResourceAttrSet("bitwarden_group_members.test", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "bitwarden_group_members.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			//Update and Read testing
+			{
+				Config: testAccGroupMembersResourceConfig("one@fake.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bitwarden_group_members.test", "member_ids.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccGroupMembersResourceConfig(emails ...string) string {
+	builder := strings.Builder{}
+	builder.WriteString("resource \"bitwarden_group\" \"test\" {\n")
+	builder.WriteString("name = \"group-members-test\"\n")
+	builder.WriteString("}\n")
+	memberIds := make([]string, 0, len(emails))
+	for i, email := range emails {
+		builder.WriteString(fmt.Sprintf("resource \"bitwarden_member\" \"test%d\" {\n", i))
+		builder.WriteString("type = 2\n")
+		builder.WriteString(fmt.Sprintf("email = %[1]q\n", email))
+		builder.WriteString("}\n")
+		memberIds = append(memberIds, fmt.Sprintf("bitwarden_member.test%d.id", i))
+	}
+	builder.WriteString("resource \"bitwarden_group_members\" \"test\" {\n")
+	builder.WriteString("group_id = bitwarden_group.test.id\n")
+	builder.WriteString(fmt.Sprintf("member_ids = [%s]\n", strings.Join(memberIds, ", ")))
+	builder.WriteString("}")
+
+	return builder.String()
+}
+
+func TestPreferKnownIDs(t *testing.T) {
+	ids := preferKnownIDs([]string{"abc-def", "012-345"}, []string{"ABC-DEF", "678-9AB"})
+
+	if expected := []string{"ABC-DEF", "012-345"}; !slices.Equal(ids, expected) {
+		t.Errorf("expected %v, got %v", expected, ids)
+	}
+}
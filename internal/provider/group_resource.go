@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -39,7 +41,18 @@ type groupResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	ExternalId  types.String `tfsdk:"external_id"`
 	AccessAll   types.Bool   `tfsdk:"access_all"`
+	Collections types.Set    `tfsdk:"collections"`
 	LastUpdated types.String `tfsdk:"last_updated"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// groupFieldPaths maps the fields of bitwarden.Group to their attribute, to report API validation errors.
+var groupFieldPaths = map[string]path.Path{
+	"name":        path.Root("name"),
+	"externalId":  path.Root("external_id"),
+	"accessAll":   path.Root("access_all"),
+	"collections": path.Root("collections"),
 }
 
 // Metadata returns the resource type name.
@@ -67,7 +80,7 @@ func (r *groupResource) Configure(_ context.Context, req resource.ConfigureReque
 }
 
 // Schema defines the schema for the resource.
-func (r *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *groupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -89,10 +102,23 @@ func (r *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional: true,
 				Default:  booldefault.StaticBool(false),
 			},
+			"collections": associationSetAttribute(
+				"The collections the members of this group have access to, together with the permissions they are granted. Ignored by Bitwarden when access_all is set. "+
+					"When omitted, the collection access is not managed by this resource, e.g. to grant it with the groups of bitwarden_collection instead",
+				"The collection's unique identifier within the organization",
+			),
 			"last_updated": schema.StringAttribute{
 				Computed: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -105,18 +131,37 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	collections, diags := associationsFromSet(ctx, plan.Collections)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	group := bitwarden.Group{
-		Name:       plan.Name.ValueString(),
-		ExternalId: plan.ExternalId.ValueString(),
-		AccessAll:  plan.AccessAll.ValueBool(),
+		Name:        plan.Name.ValueString(),
+		ExternalId:  plan.ExternalId.ValueString(),
+		AccessAll:   plan.AccessAll.ValueBool(),
+		Collections: collections,
 	}
 
 	// Create new group
 	newGroup, err := (*r.client).CreateGroup(ctx, group)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(
+			&resp.Diagnostics,
 			"Error creating group",
-			"Could not create group, unexpected error: "+err.Error(),
+			"Could not create group, unexpected error: ",
+			err,
+			groupFieldPaths,
 		)
 		return
 	}
@@ -126,6 +171,8 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 	plan.ExternalId = types.StringValue(newGroup.ExternalId)
 	plan.Name = types.StringValue(newGroup.Name)
 	plan.AccessAll = types.BoolValue(newGroup.AccessAll)
+	plan.Collections, diags = associationsToSet(ctx, newGroup.Collections)
+	resp.Diagnostics.Append(diags...)
 	plan.LastUpdated = types.StringValue(time.Now().UTC().Format(time.RFC850))
 
 	// Set state to fully populated data
@@ -145,12 +192,29 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed group value from BitWarden
 	group, err := (*r.client).GetGroup(ctx, state.ID.ValueString())
+	if bitwarden.IsNotFound(err) {
+		// The group was deleted outside of Terraform, so drop it from state and let Terraform recreate it
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(
+			&resp.Diagnostics,
 			"Error Reading Bitwarden group",
-			"Could not read Bitwarden group ID "+state.ID.ValueString()+": "+err.Error(),
+			"Could not read Bitwarden group ID "+state.ID.ValueString()+": ",
+			err,
+			nil,
 		)
 		return
 	}
@@ -160,6 +224,8 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	state.ExternalId = types.StringValue(group.ExternalId)
 	state.Name = types.StringValue(group.Name)
 	state.AccessAll = types.BoolValue(group.AccessAll)
+	state.Collections, diags = associationsToSet(ctx, group.Collections)
+	resp.Diagnostics.Append(diags...)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -179,19 +245,59 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
+	collections, diags := associationsFromSet(ctx, plan.Collections)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API replaces the collections on every update, so send the current ones back when they aren't managed
+	configured, diags := associationsConfigured(ctx, req.Config, path.Root("collections"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !configured {
+		current, err := (*r.client).GetGroup(ctx, plan.ID.ValueString())
+		if err != nil {
+			addAPIError(
+				&resp.Diagnostics,
+				"Error Updating Bitwarden group",
+				"Could not read the collections of group ID "+plan.ID.ValueString()+", unexpected error: ",
+				err,
+				nil,
+			)
+			return
+		}
+		collections = current.Collections
+	}
+
 	group := bitwarden.Group{
-		Name:       plan.Name.ValueString(),
-		ExternalId: plan.ExternalId.ValueString(),
-		AccessAll:  plan.AccessAll.ValueBool(),
+		Name:        plan.Name.ValueString(),
+		ExternalId:  plan.ExternalId.ValueString(),
+		AccessAll:   plan.AccessAll.ValueBool(),
+		Collections: collections,
 	}
 
 	// Update existing group
 	newGroup, err := (*r.client).UpdateGroup(ctx, plan.ID.ValueString(), group)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(
+			&resp.Diagnostics,
 			"Error Updating Bitwarden group",
-			"Could not update group, unexpected error: "+err.Error(),
+			"Could not update group, unexpected error: ",
+			err,
+			groupFieldPaths,
 		)
 		return
 	}
@@ -200,6 +306,8 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	plan.ExternalId = types.StringValue(newGroup.ExternalId)
 	plan.Name = types.StringValue(newGroup.Name)
 	plan.AccessAll = types.BoolValue(newGroup.AccessAll)
+	plan.Collections, diags = associationsToSet(ctx, newGroup.Collections)
+	resp.Diagnostics.Append(diags...)
 	plan.LastUpdated = types.StringValue(time.Now().UTC().Format(time.RFC850))
 
 	diags = resp.State.Set(ctx, plan)
@@ -218,17 +326,59 @@ func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing group
 	err := (*r.client).DeleteGroup(ctx, state.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
+	// Nothing left to delete when the group was already removed outside of Terraform
+	if err != nil && !bitwarden.IsNotFound(err) {
+		addAPIError(
+			&resp.Diagnostics,
 			"Error Deleting Bitwarden group",
-			"Could not delete group, unexpected error: "+err.Error(),
+			"Could not delete group, unexpected error: ",
+			err,
+			nil,
 		)
 		return
 	}
 }
 
+// ImportState imports a group by its ID, or looks the ID up by name or external_id when the import identifier is
+// prefixed with "name:" or "external_id:".
 func (r *groupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	attribute, value, found := strings.Cut(req.ID, ":")
+	if !found {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	if (attribute != "name" && attribute != "external_id") || value == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <id>, name:<name> or external_id:<external_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	match, description := groupMatcher(attribute, value)
+	matches, err := findAll((*r.client).ListGroups(ctx), match)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Importing Bitwarden group", "Could not list Bitwarden groups: ", err, nil)
+		return
+	}
+
+	group, err := uniqueMatch(matches, func(g bitwarden.Group) string { return g.ID }, description)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Bitwarden group", "Could not resolve import identifier "+req.ID+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), group.ID)...)
 }
@@ -19,6 +19,7 @@ func TestAccGroupResource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("bitwarden_group.test", "name", "one"),
 					resource.TestCheckResourceAttr("bitwarden_group.test", "access_all", "true"),
+					resource.TestCheckResourceAttr("bitwarden_group.test", "collections.#", "0"),
 					resource.TestCheckResourceAttrSet("bitwarden_group.test", "id"),
 					resource.TestCheckResourceAttrSet("bitwarden_group.test", "last_updated"),
 				),
@@ -43,6 +44,22 @@ func TestAccGroupResource(t *testing.T) {
 					resource.TestCheckResourceAttr("bitwarden_group.test", "access_all", "false"),
 				),
 			},
+			// ImportState by name testing
+			{
+				ResourceName:            "bitwarden_group.test",
+				ImportState:             true,
+				ImportStateId:           "name:two",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// ImportState by external ID testing
+			{
+				ResourceName:            "bitwarden_group.test",
+				ImportState:             true,
+				ImportStateId:           "external_id:external-two",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
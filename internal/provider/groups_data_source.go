@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &groupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &groupsDataSource{}
+)
+
+// NewGroupsDataSource is a helper function to simplify the provider implementation.
+func NewGroupsDataSource() datasource.DataSource {
+	return &groupsDataSource{}
+}
+
+// groupsDataSource is the data source implementation.
+type groupsDataSource struct {
+	client *bitwarden.Client
+}
+
+type groupsDataSourceModel struct {
+	NamePrefix types.String     `tfsdk:"name_prefix"`
+	NameRegex  types.String     `tfsdk:"name_regex"`
+	ExternalId types.String     `tfsdk:"external_id"`
+	Groups     []groupItemModel `tfsdk:"groups"`
+}
+
+// groupItemModel is a single group as listed by the Public API, which doesn't include their collections.
+type groupItemModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	ExternalId types.String `tfsdk:"external_id"`
+	AccessAll  types.Bool   `tfsdk:"access_all"`
+}
+
+// Metadata returns the data source type name.
+func (d *groupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+func (d *groupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(bitwarden.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitwarden.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = &client
+}
+
+// Schema defines the schema for the data source.
+func (d *groupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the groups of the organization, optionally filtered. All filters must match for a group to be listed.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list groups whose name starts with this prefix",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list groups whose name matches this regular expression, in the syntax of https://pkg.go.dev/regexp/syntax",
+				Validators: []validator.String{
+					regexValidator{},
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list groups with this external identifier",
+			},
+			"groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching groups, sorted by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The group's unique identifier within the organization",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the group",
+						},
+						"external_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "External identifier for reference or linking this group to another system, such as a user directory",
+						},
+						"access_all": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Determines if the members of this group can access all collections within the organization",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *groupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state groupsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameFilter := newTextFilter(types.StringNull(), state.NamePrefix, state.NameRegex)
+	externalIdFilter := newTextFilter(state.ExternalId, types.StringNull(), types.StringNull())
+
+	groups, err := findAll((*d.client).ListGroups(ctx), func(g bitwarden.Group) bool {
+		return nameFilter.match(g.Name) && externalIdFilter.match(g.ExternalId)
+	})
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to List Bitwarden Groups", "", err, nil)
+		return
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Name < groups[j].Name
+	})
+
+	state.Groups = make([]groupItemModel, 0, len(groups))
+	for _, g := range groups {
+		state.Groups = append(state.Groups, groupItemModel{
+			ID:         types.StringValue(g.ID),
+			Name:       types.StringValue(g.Name),
+			ExternalId: types.StringValue(g.ExternalId),
+			AccessAll:  types.BoolValue(g.AccessAll),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
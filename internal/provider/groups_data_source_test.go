@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGroupsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "bitwarden_group" "test" {
+  for_each = toset(["groups-test-a", "groups-test-b", "other-groups-test"])
+  name     = each.key
+}
+
+data "bitwarden_groups" "test" {
+  name_prefix = "groups-test-"
+  name_regex  = "-b$"
+
+  depends_on = [bitwarden_group.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bitwarden_groups.test", "groups.#", "1"),
+					resource.TestCheckResourceAttrPair("data.bitwarden_groups.test", "groups.0.id", "bitwarden_group.test[\"groups-test-b\"]", "id"),
+					resource.TestCheckResourceAttr("data.bitwarden_groups.test", "groups.0.name", "groups-test-b"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// findAll consumes the iterator and returns every item for which match returns true.
+func findAll[T any](it *bitwarden.Iterator[T], match func(T) bool) ([]T, error) {
+	matches := make([]T, 0)
+	for it.Next() {
+		if item := it.Value(); match(item) {
+			matches = append(matches, item)
+		}
+	}
+
+	return matches, it.Err()
+}
+
+// uniqueMatch returns the only item of matches, or an error when there is none or more than one. The description
+// names what was searched for, e.g. `group with name "Engineering"`.
+func uniqueMatch[T any](matches []T, id func(T) string, description string) (T, error) {
+	var item T
+
+	switch len(matches) {
+	case 0:
+		return item, fmt.Errorf("no %s found", description)
+	case 1:
+		return matches[0], nil
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, m := range matches {
+			ids = append(ids, id(m))
+		}
+		return item, fmt.Errorf("found %d matches for %s, select one of them by ID: %s", len(matches), description, strings.Join(ids, ", "))
+	}
+}
+
+// textFilter matches a string against the optional filters a data source offers for an attribute, unset filters
+// match every value.
+type textFilter struct {
+	exact  *string
+	prefix string
+	regex  *regexp.Regexp
+}
+
+// newTextFilter builds a textFilter from the configured filter attributes, pass a null value for the filters the
+// attribute doesn't offer. The regex must already be validated, e.g. by regexValidator.
+func newTextFilter(exact, prefix, regex types.String) textFilter {
+	filter := textFilter{
+		prefix: prefix.ValueString(),
+	}
+	if !exact.IsNull() {
+		value := exact.ValueString()
+		filter.exact = &value
+	}
+	if !regex.IsNull() {
+		filter.regex = regexp.MustCompile(regex.ValueString())
+	}
+
+	return filter
+}
+
+func (f textFilter) match(value string) bool {
+	if f.exact != nil && value != *f.exact {
+		return false
+	}
+	if !strings.HasPrefix(value, f.prefix) {
+		return false
+	}
+	if f.regex != nil && !f.regex.MatchString(value) {
+		return false
+	}
+
+	return true
+}
+
+// groupMatcher returns the predicate and description to find groups by name or external_id, the attributes a group
+// can be looked up by besides its ID.
+func groupMatcher(attribute, value string) (func(bitwarden.Group) bool, string) {
+	if attribute == "name" {
+		return func(g bitwarden.Group) bool { return g.Name == value }, fmt.Sprintf("group with name %q", value)
+	}
+
+	return func(g bitwarden.Group) bool { return g.ExternalId == value }, fmt.Sprintf("group with external ID %q", value)
+}
+
+// memberMatcher returns the predicate and description to find members by email or external_id, the attributes a
+// member can be looked up by besides its ID. Email addresses are compared case-insensitively like Bitwarden does.
+func memberMatcher(attribute, value string) (func(bitwarden.ResponseMember) bool, string) {
+	if attribute == "email" {
+		return func(m bitwarden.ResponseMember) bool { return strings.EqualFold(m.Email, value) }, fmt.Sprintf("member with email %q", value)
+	}
+
+	return func(m bitwarden.ResponseMember) bool { return m.ExternalId == value }, fmt.Sprintf("member with external ID %q", value)
+}
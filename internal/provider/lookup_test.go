@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUniqueMatch(t *testing.T) {
+	id := func(s string) string { return s }
+
+	if _, err := uniqueMatch([]string{}, id, "group"); err == nil || err.Error() != "no group found" {
+		t.Errorf("expected no match, got %v", err)
+	}
+
+	if match, err := uniqueMatch([]string{"a"}, id, "group"); err != nil || match != "a" {
+		t.Errorf("expected a single match, got %q and %v", match, err)
+	}
+
+	if _, err := uniqueMatch([]string{"a", "b"}, id, "group"); err == nil || err.Error() != "found 2 matches for group, select one of them by ID: a, b" {
+		t.Errorf("expected an ambiguous match, got %v", err)
+	}
+}
+
+func TestTextFilter(t *testing.T) {
+	for name, tc := range map[string]struct {
+		exact, prefix, regex types.String
+		expected             bool
+	}{
+		"no filter":       {types.StringNull(), types.StringNull(), types.StringNull(), true},
+		"exact":           {types.StringValue("team-a"), types.StringNull(), types.StringNull(), true},
+		"exact mismatch":  {types.StringValue("team"), types.StringNull(), types.StringNull(), false},
+		"empty exact":     {types.StringValue(""), types.StringNull(), types.StringNull(), false},
+		"prefix":          {types.StringNull(), types.StringValue("team-"), types.StringNull(), true},
+		"prefix mismatch": {types.StringNull(), types.StringValue("ops-"), types.StringNull(), false},
+		"regex":           {types.StringNull(), types.StringNull(), types.StringValue("^team-[a-z]$"), true},
+		"regex mismatch":  {types.StringNull(), types.StringNull(), types.StringValue("^ops"), false},
+		"all":             {types.StringValue("team-a"), types.StringValue("team-"), types.StringValue("a$"), true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if actual := newTextFilter(tc.exact, tc.prefix, tc.regex).match("team-a"); actual != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &memberDataSource{}
+	_ datasource.DataSourceWithConfigure        = &memberDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &memberDataSource{}
+)
+
+// NewMemberDataSource is a helper function to simplify the provider implementation.
+func NewMemberDataSource() datasource.DataSource {
+	return &memberDataSource{}
+}
+
+// memberDataSource is the data source implementation.
+type memberDataSource struct {
+	client *bitwarden.Client
+}
+
+type memberDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Email            types.String `tfsdk:"email"`
+	ExternalId       types.String `tfsdk:"external_id"`
+	Type             types.Int64  `tfsdk:"type"`
+	AccessAll        types.Bool   `tfsdk:"access_all"`
+	Name             types.String `tfsdk:"name"`
+	Status           types.Int64  `tfsdk:"status"`
+	TwoFactorEnabled types.Bool   `tfsdk:"two_factor_enabled"`
+	Collections      types.Set    `tfsdk:"collections"`
+	GroupIDs         types.Set    `tfsdk:"group_ids"`
+}
+
+// Metadata returns the data source type name.
+func (d *memberDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_member"
+}
+
+func (d *memberDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(bitwarden.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitwarden.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = &client
+}
+
+// Schema defines the schema for the data source.
+func (d *memberDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing member of the organization by its ID, email address or external ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The member's unique identifier within the organization",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"email": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The member's email address, compared case-insensitively",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "External identifier for reference or linking this member to another system, such as a user directory",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The member's type, is one of the following:\n    Owner = 0,\n    Admin = 1,\n    User = 2,\n    Manager = 3,\n    Custom = 4.\n    See https://github.com/bitwarden/server/blob/master/src/Core/Enums/OrganizationUserType.cs",
+			},
+			"access_all": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Determines if this member can access all collections within the organization",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The member's name, set from their user account profile",
+			},
+			"status": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The member's status within the organisation, is one of the following:\n    Invited = 0,\n    Accepted = 1,\n    Confirmed = 2,\n    Revoked = -1.\n    See https://github.com/bitwarden/server/blob/master/src/Core/Enums/OrganizationUserStatusType.cs",
+			},
+			"two_factor_enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the member has enabled two-step login on their user account",
+			},
+			"collections": associationSetDataSourceAttribute(
+				"The collections this member has access to, together with the permissions they are granted",
+				"The collection's unique identifier within the organization",
+			),
+			"group_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The unique identifiers of the groups this member belongs to",
+			},
+		},
+	}
+}
+
+func (d *memberDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("email"),
+			path.MatchRoot("external_id"),
+		),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *memberDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config memberDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := config.ID.ValueString()
+	if id == "" {
+		// The Public API can't filter members, so search the whole list
+		attribute, value := "email", config.Email.ValueString()
+		if config.Email.IsNull() {
+			attribute, value = "external_id", config.ExternalId.ValueString()
+		}
+		match, description := memberMatcher(attribute, value)
+
+		matches, err := findAll((*d.client).ListMembers(ctx), match)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Unable to List Bitwarden Members", "", err, nil)
+			return
+		}
+
+		member, err := uniqueMatch(matches, func(m bitwarden.ResponseMember) string { return m.ID }, description)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attribute), "Unable to Find Bitwarden Member", err.Error())
+			return
+		}
+		id = member.ID
+	}
+
+	// The list omits the collections of the members, so always get the member itself
+	member, err := (*d.client).GetMember(ctx, id)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to Read Bitwarden Member", "Could not read Bitwarden member ID "+id+": ", err, nil)
+		return
+	}
+
+	groupIDs, err := (*d.client).GetMemberGroupIDs(ctx, id)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to Read Bitwarden Member Groups", "Could not read the groups of Bitwarden member ID "+id+": ", err, nil)
+		return
+	}
+
+	state := memberDataSourceModel{
+		ID:               types.StringValue(member.ID),
+		Email:            types.StringValue(member.Email),
+		ExternalId:       types.StringValue(member.ExternalId),
+		Type:             types.Int64Value(int64(member.Type)),
+		AccessAll:        types.BoolValue(member.AccessAll),
+		Name:             types.StringValue(member.Name),
+		Status:           types.Int64Value(member.Status),
+		TwoFactorEnabled: types.BoolValue(member.TwoFactorEnabled),
+	}
+	state.Collections, diags = associationsToSet(ctx, member.Collections)
+	resp.Diagnostics.Append(diags...)
+	state.GroupIDs, diags = types.SetValueFrom(ctx, types.StringType, groupIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMemberDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read by email testing, which ignores case
+			{
+				Config: testAccMemberDataSourceConfig(`email = upper(bitwarden_member.test.email)`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.bitwarden_member.test", "id", "bitwarden_member.test", "id"),
+					resource.TestCheckResourceAttr("data.bitwarden_member.test", "email", "member-data-source@fake.com"),
+					resource.TestCheckResourceAttr("data.bitwarden_member.test", "type", "2"),
+					resource.TestCheckResourceAttr("data.bitwarden_member.test", "status", "0"),
+					resource.TestCheckResourceAttr("data.bitwarden_member.test", "group_ids.#", "0"),
+				),
+			},
+			// Read by external ID testing
+			{
+				Config: testAccMemberDataSourceConfig(`external_id = bitwarden_member.test.external_id`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.bitwarden_member.test", "id", "bitwarden_member.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMemberDataSourceConfig(lookup string) string {
+	return fmt.Sprintf(`
+resource "bitwarden_member" "test" {
+  type        = 2
+  email       = "member-data-source@fake.com"
+  external_id = "member-data-source-test"
+}
+
+data "bitwarden_member" "test" {
+  %s
+}
+`, lookup)
+}
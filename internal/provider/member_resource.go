@@ -3,17 +3,22 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-bitwarden/internal/bitwarden"
@@ -37,15 +42,28 @@ type memberResource struct {
 }
 
 type memberResourceModel struct {
-	Type       types.Int64  `tfsdk:"type"`
-	AccessAll  types.Bool   `tfsdk:"access_all"`
-	ExternalId types.String `tfsdk:"external_id"`
-	Email      types.String `tfsdk:"email"`
+	Type        types.Int64  `tfsdk:"type"`
+	AccessAll   types.Bool   `tfsdk:"access_all"`
+	ExternalId  types.String `tfsdk:"external_id"`
+	Email       types.String `tfsdk:"email"`
+	Collections types.Set    `tfsdk:"collections"`
+	GroupIDs    types.Set    `tfsdk:"group_ids"`
 
 	ID          types.String `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
 	Status      types.Int64  `tfsdk:"status"`
 	LastUpdated types.String `tfsdk:"last_updated"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// memberFieldPaths maps the fields of bitwarden.Member to their attribute, to report API validation errors.
+var memberFieldPaths = map[string]path.Path{
+	"type":        path.Root("type"),
+	"accessAll":   path.Root("access_all"),
+	"externalId":  path.Root("external_id"),
+	"email":       path.Root("email"),
+	"collections": path.Root("collections"),
 }
 
 // Metadata returns the resource type name.
@@ -73,7 +91,7 @@ func (r *memberResource) Configure(_ context.Context, req resource.ConfigureRequ
 }
 
 // Schema defines the schema for the resource.
-func (r *memberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *memberResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Bitwarden member resources manage the members aka users within an Bitwarden organization. We leverage the public [Bitwarden API](https://bitwarden.com/help/api/]",
 		Attributes: map[string]schema.Attribute{
@@ -101,6 +119,21 @@ func (r *memberResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Required:    true,
 				Description: "The member's email address.",
 			},
+			"collections": associationSetAttribute(
+				"The collections this member has access to, together with the permissions they are granted. Ignored by Bitwarden when access_all is set. "+
+					"When omitted, the collection access is not managed by this resource",
+				"The collection's unique identifier within the organization",
+			),
+			"group_ids": schema.SetAttribute{
+				Computed:    true,
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The unique identifiers of the groups this member belongs to. When omitted, the group membership is not managed by this resource. " +
+					"Don't combine it with bitwarden_group_members or bitwarden_group_member for the same member",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "The member's unique identifier within the organization",
@@ -120,6 +153,14 @@ func (r *memberResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Computed: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -132,19 +173,38 @@ func (r *memberResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	collections, diags := associationsFromSet(ctx, plan.Collections)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	member := bitwarden.Member{
-		Type:       bitwarden.OrganizationUserType(plan.Type.ValueInt64()),
-		AccessAll:  plan.AccessAll.ValueBool(),
-		ExternalId: plan.ExternalId.ValueString(),
-		Email:      plan.Email.ValueString(),
+		Type:        bitwarden.OrganizationUserType(plan.Type.ValueInt64()),
+		AccessAll:   plan.AccessAll.ValueBool(),
+		ExternalId:  plan.ExternalId.ValueString(),
+		Email:       plan.Email.ValueString(),
+		Collections: collections,
 	}
 
 	// Create new member
 	newMember, err := (*r.client).CreateMember(ctx, member)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(
+			&resp.Diagnostics,
 			"Error creating member",
-			"Could not create member, unexpected error: "+err.Error(),
+			"Could not create member, unexpected error: ",
+			err,
+			memberFieldPaths,
 		)
 		return
 	}
@@ -154,6 +214,11 @@ func (r *memberResource) Create(ctx context.Context, req resource.CreateRequest,
 	plan.AccessAll = types.BoolValue(newMember.AccessAll)
 	plan.ExternalId = types.StringValue(newMember.ExternalId)
 	plan.Email = types.StringValue(newMember.Email)
+	plan.Collections, diags = associationsToSet(ctx, newMember.Collections)
+	resp.Diagnostics.Append(diags...)
+	// Keep the member in state even when its groups can't be written, so it isn't orphaned
+	plan.GroupIDs, diags = r.applyGroupIDs(ctx, req.Config, newMember.ID)
+	resp.Diagnostics.Append(diags...)
 
 	plan.ID = types.StringValue(newMember.ID)
 	plan.Name = types.StringValue(newMember.Name)
@@ -178,12 +243,29 @@ func (r *memberResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed member value from BitWarden
 	member, err := (*r.client).GetMember(ctx, state.ID.ValueString())
+	if bitwarden.IsNotFound(err) {
+		// The member was deleted outside of Terraform, so drop it from state and let Terraform recreate it
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(
+			&resp.Diagnostics,
 			"Error Reading Bitwarden member",
-			"Could not read Bitwarden member ID "+state.ID.ValueString()+": "+err.Error(),
+			"Could not read Bitwarden member ID "+state.ID.ValueString()+": ",
+			err,
+			nil,
 		)
 		return
 	}
@@ -193,6 +275,22 @@ func (r *memberResource) Read(ctx context.Context, req resource.ReadRequest, res
 	state.AccessAll = types.BoolValue(member.AccessAll)
 	state.ExternalId = types.StringValue(member.ExternalId)
 	state.Email = types.StringValue(member.Email)
+	state.Collections, diags = associationsToSet(ctx, member.Collections)
+	resp.Diagnostics.Append(diags...)
+
+	groupIDs, err := (*r.client).GetMemberGroupIDs(ctx, state.ID.ValueString())
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Reading Bitwarden member",
+			"Could not read groups of Bitwarden member ID "+state.ID.ValueString()+": ",
+			err,
+			nil,
+		)
+		return
+	}
+	state.GroupIDs, diags = types.SetValueFrom(ctx, types.StringType, groupIDs)
+	resp.Diagnostics.Append(diags...)
 
 	state.ID = types.StringValue(member.ID)
 	state.Name = types.StringValue(member.Name)
@@ -216,20 +314,60 @@ func (r *memberResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
+	collections, diags := associationsFromSet(ctx, plan.Collections)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API replaces the collections on every update, so send the current ones back when they aren't managed
+	configured, diags := associationsConfigured(ctx, req.Config, path.Root("collections"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !configured {
+		current, err := (*r.client).GetMember(ctx, plan.ID.ValueString())
+		if err != nil {
+			addAPIError(
+				&resp.Diagnostics,
+				"Error Updating Bitwarden member",
+				"Could not read the collections of member ID "+plan.ID.ValueString()+", unexpected error: ",
+				err,
+				nil,
+			)
+			return
+		}
+		collections = current.Collections
+	}
+
 	member := bitwarden.Member{
-		Type:       bitwarden.OrganizationUserType(plan.Type.ValueInt64()),
-		AccessAll:  plan.AccessAll.ValueBool(),
-		ExternalId: plan.ExternalId.ValueString(),
-		Email:      plan.Email.ValueString(),
+		Type:        bitwarden.OrganizationUserType(plan.Type.ValueInt64()),
+		AccessAll:   plan.AccessAll.ValueBool(),
+		ExternalId:  plan.ExternalId.ValueString(),
+		Email:       plan.Email.ValueString(),
+		Collections: collections,
 	}
 
 	// Update existing member
 	newMember, err := (*r.client).UpdateMember(ctx, plan.ID.ValueString(), member)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		addAPIError(
+			&resp.Diagnostics,
 			"Error Updating Bitwarden member",
-			"Could not update member, unexpected error: "+err.Error(),
+			"Could not update member, unexpected error: ",
+			err,
+			memberFieldPaths,
 		)
 		return
 	}
@@ -239,6 +377,11 @@ func (r *memberResource) Update(ctx context.Context, req resource.UpdateRequest,
 	plan.AccessAll = types.BoolValue(newMember.AccessAll)
 	plan.ExternalId = types.StringValue(newMember.ExternalId)
 	plan.Email = types.StringValue(newMember.Email)
+	plan.Collections, diags = associationsToSet(ctx, newMember.Collections)
+	resp.Diagnostics.Append(diags...)
+	// Keep the updated member in state even when its groups can't be written
+	plan.GroupIDs, diags = r.applyGroupIDs(ctx, req.Config, newMember.ID)
+	resp.Diagnostics.Append(diags...)
 
 	plan.ID = types.StringValue(newMember.ID)
 	plan.Name = types.StringValue(newMember.Name)
@@ -262,17 +405,106 @@ func (r *memberResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing member
 	err := (*r.client).DeleteMember(ctx, state.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
+	// Nothing left to delete when the member was already removed outside of Terraform
+	if err != nil && !bitwarden.IsNotFound(err) {
+		addAPIError(
+			&resp.Diagnostics,
 			"Error Deleting Bitwarden member",
-			"Could not delete member, unexpected error: "+err.Error(),
+			"Could not delete member, unexpected error: ",
+			err,
+			nil,
 		)
 		return
 	}
 }
 
+// applyGroupIDs replaces the groups of the member when group_ids is configured, and returns the groups Bitwarden
+// reports for the member afterwards.
+func (r *memberResource) applyGroupIDs(ctx context.Context, config tfsdk.Config, id string) (types.Set, diag.Diagnostics) {
+	var configured types.Set
+	diags := config.GetAttribute(ctx, path.Root("group_ids"), &configured)
+	if diags.HasError() {
+		return types.SetNull(types.StringType), diags
+	}
+
+	if !configured.IsNull() && !configured.IsUnknown() {
+		var groupIDs []string
+		diags.Append(configured.ElementsAs(ctx, &groupIDs, false)...)
+		if diags.HasError() {
+			return types.SetNull(types.StringType), diags
+		}
+
+		err := (*r.client).UpdateMemberGroupIDs(ctx, id, groupIDs)
+		if err != nil {
+			addAPIError(
+				&diags,
+				"Error Updating Bitwarden member groups",
+				"Could not update groups of member ID "+id+", unexpected error: ",
+				err,
+				map[string]path.Path{"groupIds": path.Root("group_ids")},
+			)
+			return types.SetNull(types.StringType), diags
+		}
+	}
+
+	groupIDs, err := (*r.client).GetMemberGroupIDs(ctx, id)
+	if err != nil {
+		addAPIError(
+			&diags,
+			"Error Reading Bitwarden member groups",
+			"Could not read groups of member ID "+id+", unexpected error: ",
+			err,
+			nil,
+		)
+		return types.SetNull(types.StringType), diags
+	}
+
+	set, setDiags := types.SetValueFrom(ctx, types.StringType, groupIDs)
+	diags.Append(setDiags...)
+
+	return set, diags
+}
+
+// ImportState imports a member by its ID, or looks the ID up by email or external_id when the import identifier is
+// prefixed with "email:" or "external_id:".
 func (r *memberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	attribute, value, found := strings.Cut(req.ID, ":")
+	if !found {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	if (attribute != "email" && attribute != "external_id") || value == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <id>, email:<email> or external_id:<external_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	match, description := memberMatcher(attribute, value)
+	matches, err := findAll((*r.client).ListMembers(ctx), match)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error Importing Bitwarden member", "Could not list Bitwarden members: ", err, nil)
+		return
+	}
+
+	member, err := uniqueMatch(matches, func(m bitwarden.ResponseMember) string { return m.ID }, description)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Bitwarden member", "Could not resolve import identifier "+req.ID+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), member.ID)...)
 }
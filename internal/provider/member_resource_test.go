@@ -21,6 +21,8 @@ func TestAccMemberResource(t *testing.T) {
 					resource.TestCheckResourceAttr("bitwarden_member.test", "access_all", "true"),
 					resource.TestCheckResourceAttr("bitwarden_member.test", "external_id", ""),
 					resource.TestCheckResourceAttr("bitwarden_member.test", "email", "test@fake.com"),
+					resource.TestCheckResourceAttr("bitwarden_member.test", "collections.#", "0"),
+					resource.TestCheckResourceAttr("bitwarden_member.test", "group_ids.#", "0"),
 					resource.TestCheckResourceAttr("bitwarden_member.test", "name", ""),
 					resource.TestCheckResourceAttrSet("bitwarden_member.test", "id"),
 					resource.TestCheckResourceAttrSet("bitwarden_member.test", "status"),
@@ -46,12 +48,30 @@ func TestAccMemberResource(t *testing.T) {
 					resource.TestCheckResourceAttr("bitwarden_member.test", "access_all", "false"),
 					resource.TestCheckResourceAttr("bitwarden_member.test", "external_id", "external-two"),
 					resource.TestCheckResourceAttr("bitwarden_member.test", "email", "test@fake.com"),
+					resource.TestCheckResourceAttr("bitwarden_member.test", "collections.#", "0"),
+					resource.TestCheckResourceAttr("bitwarden_member.test", "group_ids.#", "0"),
 					resource.TestCheckResourceAttr("bitwarden_member.test", "name", ""),
 					resource.TestCheckResourceAttrSet("bitwarden_member.test", "id"),
 					resource.TestCheckResourceAttrSet("bitwarden_member.test", "status"),
 					resource.TestCheckResourceAttrSet("bitwarden_member.test", "last_updated"),
 				),
 			},
+			// ImportState by email, which ignores case testing
+			{
+				ResourceName:            "bitwarden_member.test",
+				ImportState:             true,
+				ImportStateId:           "email:TEST@fake.com",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// ImportState by external ID testing
+			{
+				ResourceName:            "bitwarden_member.test",
+				ImportState:             true,
+				ImportStateId:           "external_id:external-two",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &membersDataSource{}
+	_ datasource.DataSourceWithConfigure = &membersDataSource{}
+)
+
+// memberStatuses maps the names accepted by the status filter to the status reported by the API.
+var memberStatuses = map[string]int64{
+	"invited":   0,
+	"accepted":  1,
+	"confirmed": 2,
+	"revoked":   -1,
+}
+
+// NewMembersDataSource is a helper function to simplify the provider implementation.
+func NewMembersDataSource() datasource.DataSource {
+	return &membersDataSource{}
+}
+
+// membersDataSource is the data source implementation.
+type membersDataSource struct {
+	client *bitwarden.Client
+}
+
+type membersDataSourceModel struct {
+	Status      types.String      `tfsdk:"status"`
+	Type        types.Int64       `tfsdk:"type"`
+	EmailDomain types.String      `tfsdk:"email_domain"`
+	EmailRegex  types.String      `tfsdk:"email_regex"`
+	GroupID     types.String      `tfsdk:"group_id"`
+	Members     []memberItemModel `tfsdk:"members"`
+}
+
+// memberItemModel is a single member as listed by the Public API, which doesn't include their collections.
+type memberItemModel struct {
+	ID               types.String `tfsdk:"id"`
+	Email            types.String `tfsdk:"email"`
+	ExternalId       types.String `tfsdk:"external_id"`
+	Type             types.Int64  `tfsdk:"type"`
+	AccessAll        types.Bool   `tfsdk:"access_all"`
+	Name             types.String `tfsdk:"name"`
+	Status           types.Int64  `tfsdk:"status"`
+	TwoFactorEnabled types.Bool   `tfsdk:"two_factor_enabled"`
+}
+
+// memberFilter selects members matching all of its criteria, unset criteria match every member.
+type memberFilter struct {
+	status      *int64
+	memberType  *int64
+	emailDomain string
+	emailRegex  *regexp.Regexp
+	// memberIDs holds the members of the group to filter on, nil matches every member.
+	memberIDs []string
+}
+
+func (f memberFilter) match(m bitwarden.ResponseMember) bool {
+	if f.status != nil && m.Status != *f.status {
+		return false
+	}
+	if f.memberType != nil && int64(m.Type) != *f.memberType {
+		return false
+	}
+	if f.emailDomain != "" {
+		_, domain, _ := strings.Cut(m.Email, "@")
+		if !strings.EqualFold(domain, strings.TrimPrefix(f.emailDomain, "@")) {
+			return false
+		}
+	}
+	if f.emailRegex != nil && !f.emailRegex.MatchString(m.Email) {
+		return false
+	}
+	if f.memberIDs != nil && !containsID(f.memberIDs, m.ID) {
+		return false
+	}
+
+	return true
+}
+
+// Metadata returns the data source type name.
+func (d *membersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_members"
+}
+
+func (d *membersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(bitwarden.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitwarden.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = &client
+}
+
+// Schema defines the schema for the data source.
+func (d *membersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	statuses := make([]string, 0, len(memberStatuses))
+	for status := range memberStatuses {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the members of the organization, optionally filtered. All filters must match for a member to be listed.",
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list members with this status, one of " + strings.Join(statuses, ", "),
+				Validators: []validator.String{
+					stringvalidator.OneOf(statuses...),
+				},
+			},
+			"type": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Only list members of this type, one of:\n    Owner = 0,\n    Admin = 1,\n    User = 2,\n    Manager = 3,\n    Custom = 4.",
+				Validators: []validator.Int64{
+					int64validator.OneOf([]int64{0, 1, 2, 3, 4}...),
+				},
+			},
+			"email_domain": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list members whose email address belongs to this domain, e.g. example.com. The comparison ignores case",
+			},
+			"email_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list members whose email address matches this regular expression, in the syntax of https://pkg.go.dev/regexp/syntax",
+				Validators: []validator.String{
+					regexValidator{},
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the members of the group with this unique identifier",
+			},
+			"members": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching members, sorted by email address",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The member's unique identifier within the organization",
+						},
+						"email": schema.StringAttribute{
+							Computed:    true,
+							Description: "The member's email address",
+						},
+						"external_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "External identifier for reference or linking this member to another system, such as a user directory",
+						},
+						"type": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The member's type, see the type filter for the possible values",
+						},
+						"access_all": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Determines if this member can access all collections within the organization",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The member's name, set from their user account profile",
+						},
+						"status": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The member's status within the organisation: Invited = 0, Accepted = 1, Confirmed = 2, Revoked = -1",
+						},
+						"two_factor_enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the member has enabled two-step login on their user account",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *membersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state membersDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := memberFilter{
+		emailDomain: state.EmailDomain.ValueString(),
+	}
+	if !state.Status.IsNull() {
+		status := memberStatuses[state.Status.ValueString()]
+		filter.status = &status
+	}
+	if !state.Type.IsNull() {
+		memberType := state.Type.ValueInt64()
+		filter.memberType = &memberType
+	}
+	if !state.EmailRegex.IsNull() {
+		// Already validated by regexValidator
+		filter.emailRegex = regexp.MustCompile(state.EmailRegex.ValueString())
+	}
+	if !state.GroupID.IsNull() {
+		memberIDs, err := (*d.client).GetGroupMemberIDs(ctx, state.GroupID.ValueString())
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Unable to Read Bitwarden Group Members", "Could not read the members of Bitwarden group ID "+state.GroupID.ValueString()+": ", err, nil)
+			return
+		}
+
+		// An empty group matches no member, unlike a nil filter
+		filter.memberIDs = append([]string{}, memberIDs...)
+	}
+
+	members, err := findAll((*d.client).ListMembers(ctx), filter.match)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to List Bitwarden Members", "", err, nil)
+		return
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Email < members[j].Email
+	})
+
+	state.Members = make([]memberItemModel, 0, len(members))
+	for _, m := range members {
+		state.Members = append(state.Members, memberItemModel{
+			ID:               types.StringValue(m.ID),
+			Email:            types.StringValue(m.Email),
+			ExternalId:       types.StringValue(m.ExternalId),
+			Type:             types.Int64Value(int64(m.Type)),
+			AccessAll:        types.BoolValue(m.AccessAll),
+			Name:             types.StringValue(m.Name),
+			Status:           types.Int64Value(m.Status),
+			TwoFactorEnabled: types.BoolValue(m.TwoFactorEnabled),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
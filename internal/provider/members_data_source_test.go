@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+func TestAccMembersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "bitwarden_group" "test" {
+  name = "members-data-source-test"
+}
+
+resource "bitwarden_member" "test" {
+  type      = 2
+  email     = "members-data-source@fake.com"
+  group_ids = [bitwarden_group.test.id]
+}
+
+data "bitwarden_members" "test" {
+  status       = "invited"
+  email_domain = "FAKE.com"
+  email_regex  = "^members-"
+  group_id     = bitwarden_member.test.group_ids[0]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bitwarden_members.test", "members.#", "1"),
+					resource.TestCheckResourceAttrPair("data.bitwarden_members.test", "members.0.id", "bitwarden_member.test", "id"),
+					resource.TestCheckResourceAttr("data.bitwarden_members.test", "members.0.email", "members-data-source@fake.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestMemberFilter(t *testing.T) {
+	confirmed := int64(2)
+	admin := int64(1)
+
+	member := bitwarden.ResponseMember{
+		Member: bitwarden.Member{Type: bitwarden.Admin, Email: "alice@Example.com"},
+		ID:     "member-id",
+		Status: 2,
+	}
+
+	for name, tc := range map[string]struct {
+		filter   memberFilter
+		expected bool
+	}{
+		"empty":            {memberFilter{}, true},
+		"status":           {memberFilter{status: &confirmed}, true},
+		"type":             {memberFilter{memberType: &admin}, true},
+		"domain":           {memberFilter{emailDomain: "example.COM"}, true},
+		"domain with @":    {memberFilter{emailDomain: "@example.com"}, true},
+		"other domain":     {memberFilter{emailDomain: "ample.com"}, false},
+		"regex":            {memberFilter{emailRegex: regexp.MustCompile("^alice@")}, true},
+		"regex mismatch":   {memberFilter{emailRegex: regexp.MustCompile("^bob@")}, false},
+		"group":            {memberFilter{memberIDs: []string{"member-id"}}, true},
+		"group other case": {memberFilter{memberIDs: []string{"MEMBER-ID"}}, true},
+		"empty group":      {memberFilter{memberIDs: []string{}}, false},
+		"partial mismatch": {memberFilter{status: &confirmed, memberType: &confirmed}, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if actual := tc.filter.match(member); actual != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// Constraints Bitwarden enforces on the policy settings, see the models of
+// https://github.com/bitwarden/server/tree/main/src/Core/AdminConsole/Models/Data/Organizations/Policies
+const (
+	masterPasswordMaxComplexity = 4
+	masterPasswordMinLength     = 12
+	masterPasswordMaxLength     = 128
+
+	passwordGeneratorMinLength      = 5
+	passwordGeneratorMaxLength      = 128
+	passwordGeneratorMaxMinCount    = 9
+	passwordGeneratorMinNumberWords = 3
+	passwordGeneratorMaxNumberWords = 20
+)
+
+// passwordGeneratorTypes are the generator types the password generator policy can enforce.
+var passwordGeneratorTypes = []string{"password", "passphrase"}
+
+// policyTypeSpec describes a policy type and the settings it accepts.
+type policyTypeSpec struct {
+	policyType bitwarden.PolicyType
+	// newData returns a pointer to the typed settings of the policy, nil for policy types without settings.
+	newData func() any
+	// validate checks the settings returned by newData against the constraints enforced by Bitwarden.
+	validate func(data any) error
+}
+
+// policyTypes maps the policy type names used in the configuration to their specification.
+var policyTypes = map[string]policyTypeSpec{
+	"two_factor_authentication": {policyType: bitwarden.PolicyTwoFactorAuthentication},
+	"master_password": {
+		policyType: bitwarden.PolicyMasterPassword,
+		newData:    func() any { return &bitwarden.MasterPasswordPolicyData{} },
+		validate:   validateAs(validateMasterPasswordData),
+	},
+	"password_generator": {
+		policyType: bitwarden.PolicyPasswordGenerator,
+		newData:    func() any { return &bitwarden.PasswordGeneratorPolicyData{} },
+		validate:   validateAs(validatePasswordGeneratorData),
+	},
+	"single_org":         {policyType: bitwarden.PolicySingleOrg},
+	"require_sso":        {policyType: bitwarden.PolicyRequireSso},
+	"personal_ownership": {policyType: bitwarden.PolicyPersonalOwnership},
+	"disable_send":       {policyType: bitwarden.PolicyDisableSend},
+	"send_options": {
+		policyType: bitwarden.PolicySendOptions,
+		newData:    func() any { return &bitwarden.SendOptionsPolicyData{} },
+	},
+	"reset_password": {
+		policyType: bitwarden.PolicyResetPassword,
+		newData:    func() any { return &bitwarden.ResetPasswordPolicyData{} },
+	},
+	"maximum_vault_timeout": {
+		policyType: bitwarden.PolicyMaximumVaultTimeout,
+		newData:    func() any { return &bitwarden.MaximumVaultTimeoutPolicyData{} },
+		validate:   validateAs(validateMaximumVaultTimeoutData),
+	},
+	"disable_personal_vault_export": {policyType: bitwarden.PolicyDisablePersonalVaultExport},
+}
+
+// policyTypeNames returns the sorted names of policyTypes.
+func policyTypeNames() []string {
+	names := make([]string, 0, len(policyTypes))
+	for name := range policyTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// validatePolicyData checks JSON encoded settings against the settings the policy type accepts, rejecting unknown
+// fields so that typos don't go unnoticed.
+func validatePolicyData(name string, data string) error {
+	spec := policyTypes[name]
+	if spec.newData == nil {
+		if data != "" {
+			return fmt.Errorf("the %s policy has no settings, remove data", name)
+		}
+		return nil
+	}
+
+	if data == "" {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(data)))
+	decoder.DisallowUnknownFields()
+	v := spec.newData()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("invalid settings for the %s policy: %w", name, err)
+	}
+
+	if spec.validate == nil {
+		return nil
+	}
+
+	return spec.validate(v)
+}
+
+// policyDataEqual reports whether two JSON encoded settings of a policy type are equivalent, ignoring formatting,
+// field order and fields set to their default value. Empty settings are equivalent to null.
+func policyDataEqual(name string, a, b string) bool {
+	spec := policyTypes[name]
+	if spec.newData == nil {
+		return true
+	}
+
+	decode := func(data string) (any, bool) {
+		v := spec.newData()
+		if data == "" || data == "null" {
+			return v, true
+		}
+		return v, json.Unmarshal([]byte(data), v) == nil
+	}
+
+	va, okA := decode(a)
+	vb, okB := decode(b)
+
+	return okA && okB && reflect.DeepEqual(va, vb)
+}
+
+// validateAs adapts a validation function of typed settings to policyTypeSpec.validate.
+func validateAs[T any](validate func(*T) error) func(any) error {
+	return func(data any) error {
+		return validate(data.(*T)) //nolint:forcetypeassert // newData returns a *T
+	}
+}
+
+func validateMasterPasswordData(data *bitwarden.MasterPasswordPolicyData) error {
+	return errors.Join(
+		checkRange("minComplexity", data.MinComplexity, 0, masterPasswordMaxComplexity),
+		checkRange("minLength", data.MinLength, masterPasswordMinLength, masterPasswordMaxLength),
+	)
+}
+
+func validatePasswordGeneratorData(data *bitwarden.PasswordGeneratorPolicyData) error {
+	var errs []error
+	if data.OverridePasswordType != "" && !slices.Contains(passwordGeneratorTypes, data.OverridePasswordType) {
+		errs = append(errs, fmt.Errorf("overridePasswordType must be one of %s, got %q", strings.Join(passwordGeneratorTypes, ", "), data.OverridePasswordType))
+	}
+
+	return errors.Join(append(errs,
+		checkRange("minLength", data.MinLength, passwordGeneratorMinLength, passwordGeneratorMaxLength),
+		checkRange("minNumbers", data.MinNumbers, 0, passwordGeneratorMaxMinCount),
+		checkRange("minSpecial", data.MinSpecial, 0, passwordGeneratorMaxMinCount),
+		checkRange("minNumberWords", data.MinNumberWords, passwordGeneratorMinNumberWords, passwordGeneratorMaxNumberWords),
+	)...)
+}
+
+func validateMaximumVaultTimeoutData(data *bitwarden.MaximumVaultTimeoutPolicyData) error {
+	var errs []error
+	if data.Minutes == nil {
+		errs = append(errs, errors.New("minutes is required"))
+	} else if *data.Minutes < 1 {
+		errs = append(errs, fmt.Errorf("minutes must be at least 1, got %d", *data.Minutes))
+	}
+	if data.Action != "" && data.Action != "lock" && data.Action != "logOut" {
+		errs = append(errs, fmt.Errorf("action must be lock or logOut, got %q", data.Action))
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkRange checks that an optional setting lies within [lower, upper].
+func checkRange(field string, value *int64, lower, upper int64) error {
+	if value == nil || (*value >= lower && *value <= upper) {
+		return nil
+	}
+
+	return fmt.Errorf("%s must be between %d and %d, got %d", field, lower, upper, *value)
+}
+
+// normalizePolicyData decodes the settings of a policy into their typed model and encodes them again, so that every
+// setting is present regardless of the settings Bitwarden returned. Policy types without settings return an empty string.
+func normalizePolicyData(name string, data []byte) (string, error) {
+	spec := policyTypes[name]
+	if spec.newData == nil {
+		return "", nil
+	}
+
+	v := spec.newData()
+	if len(data) != 0 && string(data) != "null" {
+		if err := json.Unmarshal(data, v); err != nil {
+			return "", fmt.Errorf("invalid settings for the %s policy: %w", name, err)
+		}
+	}
+
+	normalized, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(normalized), nil
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &policiesDataSource{}
+	_ datasource.DataSourceWithConfigure = &policiesDataSource{}
+)
+
+// NewPoliciesDataSource is a helper function to simplify the provider implementation.
+func NewPoliciesDataSource() datasource.DataSource {
+	return &policiesDataSource{}
+}
+
+// policiesDataSource is the data source implementation.
+type policiesDataSource struct {
+	client *bitwarden.Client
+}
+
+type policiesDataSourceModel struct {
+	Policies map[string]policyItemModel `tfsdk:"policies"`
+}
+
+// policyItemModel is the current state of a single policy type.
+type policyItemModel struct {
+	ID      types.String `tfsdk:"id"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Data    types.String `tfsdk:"data"`
+}
+
+// Metadata returns the data source type name.
+func (d *policiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policies"
+}
+
+func (d *policiesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(bitwarden.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitwarden.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = &client
+}
+
+// Schema defines the schema for the data source.
+func (d *policiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the current state of every policy type of the organization, including the policies not managed by Terraform. " +
+			"Use it to assert on the policies in check blocks.",
+		Attributes: map[string]schema.Attribute{
+			"policies": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "The policies keyed by their type, one of " + strings.Join(policyTypeNames(), ", "),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The policy's unique identifier within the organization, null if the policy was never configured",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the policy is enforced",
+						},
+						"data": schema.StringAttribute{
+							Computed: true,
+							Description: "JSON encoded settings of the policy including every setting of its type, use jsondecode. " +
+								"Null for the policy types without settings",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *policiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state policiesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies, err := (*d.client).ListPolicies(ctx).Collect()
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to List Bitwarden Policies", "", err, nil)
+		return
+	}
+
+	state.Policies, err = policyItems(policies)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Decode Bitwarden Policies", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// policyItems maps the policies returned by Bitwarden to every known policy type. The policy types that were never
+// configured are disabled with default settings, and policy types unknown to the provider are left out.
+func policyItems(policies []bitwarden.Policy) (map[string]policyItemModel, error) {
+	configured := make(map[bitwarden.PolicyType]bitwarden.Policy, len(policies))
+	for _, policy := range policies {
+		configured[policy.Type] = policy
+	}
+
+	items := make(map[string]policyItemModel, len(policyTypes))
+	for name, spec := range policyTypes {
+		policy, ok := configured[spec.policyType]
+
+		data, err := normalizePolicyData(name, policy.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		item := policyItemModel{
+			ID:      types.StringNull(),
+			Enabled: types.BoolValue(policy.Enabled),
+			Data:    types.StringNull(),
+		}
+		if ok {
+			item.ID = types.StringValue(policy.ID)
+		}
+		if data != "" {
+			item.Data = types.StringValue(data)
+		}
+		items[name] = item
+	}
+
+	return items, nil
+}
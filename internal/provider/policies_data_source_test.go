@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPoliciesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "bitwarden_policy" "test" {
+  type    = "send_options"
+  enabled = true
+  data = jsonencode({
+    disableHideEmail = true
+  })
+}
+
+data "bitwarden_policies" "test" {
+  depends_on = [bitwarden_policy.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bitwarden_policies.test", "policies.%", "11"),
+					resource.TestCheckResourceAttrPair("data.bitwarden_policies.test", "policies.send_options.id", "bitwarden_policy.test", "id"),
+					resource.TestCheckResourceAttr("data.bitwarden_policies.test", "policies.send_options.enabled", "true"),
+					resource.TestCheckResourceAttr("data.bitwarden_policies.test", "policies.send_options.data", `{"disableHideEmail":true}`),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+func TestValidatePolicyData(t *testing.T) {
+	for name, tc := range map[string]struct {
+		policyType, data string
+		valid            bool
+	}{
+		"no data":                     {"master_password", "", true},
+		"master password":             {"master_password", `{"minComplexity":4,"minLength":14,"requireUpper":true}`, true},
+		"master password too short":   {"master_password", `{"minLength":8}`, false},
+		"master password complexity":  {"master_password", `{"minComplexity":5}`, false},
+		"unknown field":               {"master_password", `{"minLenght":14}`, false},
+		"invalid json":                {"master_password", `{`, false},
+		"password generator":          {"password_generator", `{"overridePasswordType":"passphrase","minNumberWords":4}`, true},
+		"password generator type":     {"password_generator", `{"overridePasswordType":"pin"}`, false},
+		"password generator numbers":  {"password_generator", `{"minNumbers":10}`, false},
+		"vault timeout":               {"maximum_vault_timeout", `{"minutes":60,"action":"lock"}`, true},
+		"vault timeout minutes":       {"maximum_vault_timeout", `{"action":"logOut"}`, false},
+		"vault timeout action":        {"maximum_vault_timeout", `{"minutes":60,"action":"close"}`, false},
+		"policy without settings":     {"single_org", "", true},
+		"policy without settings set": {"single_org", `{"enabled":true}`, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := validatePolicyData(tc.policyType, tc.data)
+			if tc.valid && err != nil {
+				t.Errorf("expected valid data, got %v", err)
+			}
+			if !tc.valid && err == nil {
+				t.Error("expected invalid data")
+			}
+		})
+	}
+}
+
+func TestPolicyDataEqual(t *testing.T) {
+	for name, tc := range map[string]struct {
+		policyType, a, b string
+		expected         bool
+	}{
+		"same":            {"master_password", `{"minLength":14}`, `{"minLength":14}`, true},
+		"server defaults": {"master_password", `{"minLength":14}`, `{"minComplexity":null,"minLength":14,"requireUpper":false}`, true},
+		"field order":     {"send_options", `{"disableHideEmail":true}`, ` { "disableHideEmail" : true }`, true},
+		"null":            {"master_password", "", "null", true},
+		"empty":           {"master_password", "", `{"requireUpper":false}`, true},
+		"different":       {"master_password", `{"minLength":14}`, `{"minLength":16}`, false},
+		"no settings":     {"single_org", "", "null", true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if actual := policyDataEqual(tc.policyType, tc.a, tc.b); actual != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPolicyItems(t *testing.T) {
+	items, err := policyItems([]bitwarden.Policy{
+		{ID: "1", Type: bitwarden.PolicyTwoFactorAuthentication, Enabled: true},
+		{ID: "2", Type: bitwarden.PolicyMasterPassword, Enabled: true, Data: []byte(`{"minLength":14}`)},
+		{ID: "3", Type: 99, Enabled: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != len(policyTypes) {
+		t.Fatalf("expected an item per policy type, got %d items", len(items))
+	}
+
+	if item := items["two_factor_authentication"]; item.ID.ValueString() != "1" || !item.Enabled.ValueBool() || !item.Data.IsNull() {
+		t.Errorf("unexpected two_factor_authentication item %+v", item)
+	}
+
+	expectedData := `{"minComplexity":null,"minLength":14,"requireUpper":false,"requireLower":false,"requireNumbers":false,"requireSpecial":false,"enforceOnLogin":false}`
+	if item := items["master_password"]; item.ID.ValueString() != "2" || item.Data.ValueString() != expectedData {
+		t.Errorf("unexpected master_password item %+v", item)
+	}
+
+	if item := items["single_org"]; !item.ID.IsNull() || item.Enabled.ValueBool() {
+		t.Errorf("expected a disabled single_org item, got %+v", item)
+	}
+
+	if item := items["send_options"]; item.Data.ValueString() != `{"disableHideEmail":false}` {
+		t.Errorf("expected default send_options settings, got %+v", item)
+	}
+}
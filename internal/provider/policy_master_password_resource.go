@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &typedPolicyResource[policyMasterPasswordResourceModel, *policyMasterPasswordResourceModel]{}
+	_ resource.ResourceWithConfigure   = &typedPolicyResource[policyMasterPasswordResourceModel, *policyMasterPasswordResourceModel]{}
+	_ resource.ResourceWithImportState = &typedPolicyResource[policyMasterPasswordResourceModel, *policyMasterPasswordResourceModel]{}
+)
+
+// NewPolicyMasterPasswordResource is a helper function to simplify the provider implementation.
+func NewPolicyMasterPasswordResource() resource.Resource {
+	return &typedPolicyResource[policyMasterPasswordResourceModel, *policyMasterPasswordResourceModel]{
+		name:       "master_password",
+		label:      "master password",
+		schema:     policyMasterPasswordSchema(),
+		fieldPaths: policyMasterPasswordFieldPaths,
+	}
+}
+
+type policyMasterPasswordResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	MinComplexity  types.Int64  `tfsdk:"min_complexity"`
+	MinLength      types.Int64  `tfsdk:"min_length"`
+	RequireUpper   types.Bool   `tfsdk:"require_upper"`
+	RequireLower   types.Bool   `tfsdk:"require_lower"`
+	RequireNumbers types.Bool   `tfsdk:"require_numbers"`
+	RequireSpecial types.Bool   `tfsdk:"require_special"`
+	EnforceOnLogin types.Bool   `tfsdk:"enforce_on_login"`
+	LastUpdated    types.String `tfsdk:"last_updated"`
+}
+
+// policyMasterPasswordFieldPaths maps the fields of bitwarden.MasterPasswordPolicyData to their attribute, to report
+// API validation errors.
+var policyMasterPasswordFieldPaths = map[string]path.Path{
+	"enabled":        path.Root("enabled"),
+	"minComplexity":  path.Root("min_complexity"),
+	"minLength":      path.Root("min_length"),
+	"requireUpper":   path.Root("require_upper"),
+	"requireLower":   path.Root("require_lower"),
+	"requireNumbers": path.Root("require_numbers"),
+	"requireSpecial": path.Root("require_special"),
+	"enforceOnLogin": path.Root("enforce_on_login"),
+}
+
+// policyMasterPasswordSchema returns the schema of the bitwarden_policy_master_password resource.
+func policyMasterPasswordSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "The Bitwarden master password policy resource sets the minimum requirements for the master passwords of the organization members. " +
+			"Don't combine it with a `bitwarden_policy` of type master_password. Policies can't be deleted: destroying this resource disables the policy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The policy's unique identifier within the organization",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Whether the policy is enforced",
+				Default:     booldefault.StaticBool(true),
+			},
+			"min_complexity": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The minimum password strength score, from 0 (weak) to %d (strong)", masterPasswordMaxComplexity),
+				Validators: []validator.Int64{
+					int64validator.Between(0, masterPasswordMaxComplexity),
+				},
+			},
+			"min_length": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The minimum length of the master password, between %d and %d", masterPasswordMinLength, masterPasswordMaxLength),
+				Validators: []validator.Int64{
+					int64validator.Between(masterPasswordMinLength, masterPasswordMaxLength),
+				},
+			},
+			"require_upper": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Requires at least one uppercase character (A-Z)",
+				Default:     booldefault.StaticBool(false),
+			},
+			"require_lower": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Requires at least one lowercase character (a-z)",
+				Default:     booldefault.StaticBool(false),
+			},
+			"require_numbers": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Requires at least one number (0-9)",
+				Default:     booldefault.StaticBool(false),
+			},
+			"require_special": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Requires at least one special character (!@#$%^&*)",
+				Default:     booldefault.StaticBool(false),
+			},
+			"enforce_on_login": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Requires existing members to update a master password that doesn't meet the requirements when they log in",
+				Default:     booldefault.StaticBool(false),
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// policy returns the policy described by the model.
+func (m *policyMasterPasswordResourceModel) policy() (bitwarden.Policy, error) {
+	policy := bitwarden.Policy{
+		Type:    bitwarden.PolicyMasterPassword,
+		Enabled: m.Enabled.ValueBool(),
+	}
+	err := policy.EncodeData(bitwarden.MasterPasswordPolicyData{
+		MinComplexity:  m.MinComplexity.ValueInt64Pointer(),
+		MinLength:      m.MinLength.ValueInt64Pointer(),
+		RequireUpper:   m.RequireUpper.ValueBool(),
+		RequireLower:   m.RequireLower.ValueBool(),
+		RequireNumbers: m.RequireNumbers.ValueBool(),
+		RequireSpecial: m.RequireSpecial.ValueBool(),
+		EnforceOnLogin: m.EnforceOnLogin.ValueBool(),
+	})
+
+	return policy, err
+}
+
+// fromPolicy overwrites the model with the policy returned by Bitwarden.
+func (m *policyMasterPasswordResourceModel) fromPolicy(policy *bitwarden.Policy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data := bitwarden.MasterPasswordPolicyData{}
+	if err := policy.DecodeData(&data); err != nil {
+		diags.AddError("Unexpected Master Password Policy Data", "Could not decode the settings of the master password policy: "+err.Error())
+		return diags
+	}
+
+	m.ID = types.StringValue(policy.ID)
+	m.Enabled = types.BoolValue(policy.Enabled)
+	m.MinComplexity = types.Int64PointerValue(data.MinComplexity)
+	m.MinLength = types.Int64PointerValue(data.MinLength)
+	m.RequireUpper = types.BoolValue(data.RequireUpper)
+	m.RequireLower = types.BoolValue(data.RequireLower)
+	m.RequireNumbers = types.BoolValue(data.RequireNumbers)
+	m.RequireSpecial = types.BoolValue(data.RequireSpecial)
+	m.EnforceOnLogin = types.BoolValue(data.EnforceOnLogin)
+
+	return diags
+}
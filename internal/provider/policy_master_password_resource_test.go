@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPolicyMasterPasswordResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config:      testAccPolicyMasterPasswordResourceConfig(8, 3),
+				ExpectError: regexp.MustCompile(`Attribute min_length value must be between 12 and 128`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccPolicyMasterPasswordResourceConfig(14, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bitwarden_policy_master_password.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("bitwarden_policy_master_password.test", "min_length", "14"),
+					resource.TestCheckResourceAttr("bitwarden_policy_master_password.test", "min_complexity", "3"),
+					resource.TestCheckResourceAttr("bitwarden_policy_master_password.test", "require_upper", "true"),
+					resource.TestCheckResourceAttr("bitwarden_policy_master_password.test", "require_special", "false"),
+					resource.TestCheckResourceAttrSet("bitwarden_policy_master_password.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "bitwarden_policy_master_password.test",
+				ImportState:             true,
+				ImportStateId:           "master_password",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			//Update and Read testing
+			{
+				Config: testAccPolicyMasterPasswordResourceConfig(16, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bitwarden_policy_master_password.test", "min_length", "16"),
+					resource.TestCheckResourceAttr("bitwarden_policy_master_password.test", "min_complexity", "4"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccPolicyMasterPasswordResourceConfig(minLength, minComplexity int) string {
+	return fmt.Sprintf(`
+resource "bitwarden_policy_master_password" "test" {
+  min_length     = %d
+  min_complexity = %d
+  require_upper  = true
+}
+`, minLength, minComplexity)
+}
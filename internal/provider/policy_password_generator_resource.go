@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &typedPolicyResource[policyPasswordGeneratorResourceModel, *policyPasswordGeneratorResourceModel]{}
+	_ resource.ResourceWithConfigure   = &typedPolicyResource[policyPasswordGeneratorResourceModel, *policyPasswordGeneratorResourceModel]{}
+	_ resource.ResourceWithImportState = &typedPolicyResource[policyPasswordGeneratorResourceModel, *policyPasswordGeneratorResourceModel]{}
+)
+
+// NewPolicyPasswordGeneratorResource is a helper function to simplify the provider implementation.
+func NewPolicyPasswordGeneratorResource() resource.Resource {
+	return &typedPolicyResource[policyPasswordGeneratorResourceModel, *policyPasswordGeneratorResourceModel]{
+		name:       "password_generator",
+		label:      "password generator",
+		schema:     policyPasswordGeneratorSchema(),
+		fieldPaths: policyPasswordGeneratorFieldPaths,
+	}
+}
+
+type policyPasswordGeneratorResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	OverridePasswordType types.String `tfsdk:"override_password_type"`
+	MinLength            types.Int64  `tfsdk:"min_length"`
+	UseUpper             types.Bool   `tfsdk:"use_upper"`
+	UseLower             types.Bool   `tfsdk:"use_lower"`
+	UseNumbers           types.Bool   `tfsdk:"use_numbers"`
+	UseSpecial           types.Bool   `tfsdk:"use_special"`
+	MinNumbers           types.Int64  `tfsdk:"min_numbers"`
+	MinSpecial           types.Int64  `tfsdk:"min_special"`
+	MinNumberWords       types.Int64  `tfsdk:"min_number_words"`
+	Capitalize           types.Bool   `tfsdk:"capitalize"`
+	IncludeNumber        types.Bool   `tfsdk:"include_number"`
+	LastUpdated          types.String `tfsdk:"last_updated"`
+}
+
+// policyPasswordGeneratorFieldPaths maps the fields of bitwarden.PasswordGeneratorPolicyData to their attribute, to
+// report API validation errors.
+var policyPasswordGeneratorFieldPaths = map[string]path.Path{
+	"enabled":              path.Root("enabled"),
+	"overridePasswordType": path.Root("override_password_type"),
+	"minLength":            path.Root("min_length"),
+	"useUpper":             path.Root("use_upper"),
+	"useLower":             path.Root("use_lower"),
+	"useNumbers":           path.Root("use_numbers"),
+	"useSpecial":           path.Root("use_special"),
+	"minNumbers":           path.Root("min_numbers"),
+	"minSpecial":           path.Root("min_special"),
+	"minNumberWords":       path.Root("min_number_words"),
+	"capitalize":           path.Root("capitalize"),
+	"includeNumber":        path.Root("include_number"),
+}
+
+// policyPasswordGeneratorSchema returns the schema of the bitwarden_policy_password_generator resource.
+func policyPasswordGeneratorSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "The Bitwarden password generator policy resource sets the minimum requirements for the passwords and passphrases members generate. " +
+			"Don't combine it with a `bitwarden_policy` of type password_generator. Policies can't be deleted: destroying this resource disables the policy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The policy's unique identifier within the organization",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Whether the policy is enforced",
+				Default:     booldefault.StaticBool(true),
+			},
+			"override_password_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Forces the generator type, one of " + strings.Join(passwordGeneratorTypes, ", ") + ". When omitted, members choose the generator type",
+				Validators: []validator.String{
+					stringvalidator.OneOf(passwordGeneratorTypes...),
+				},
+			},
+			"min_length": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The minimum length of generated passwords, between %d and %d", passwordGeneratorMinLength, passwordGeneratorMaxLength),
+				Validators: []validator.Int64{
+					int64validator.Between(passwordGeneratorMinLength, passwordGeneratorMaxLength),
+				},
+			},
+			"use_upper": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Requires generated passwords to contain uppercase characters (A-Z)",
+				Default:     booldefault.StaticBool(false),
+			},
+			"use_lower": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Requires generated passwords to contain lowercase characters (a-z)",
+				Default:     booldefault.StaticBool(false),
+			},
+			"use_numbers": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Requires generated passwords to contain numbers (0-9)",
+				Default:     booldefault.StaticBool(false),
+			},
+			"use_special": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Requires generated passwords to contain special characters (!@#$%^&*)",
+				Default:     booldefault.StaticBool(false),
+			},
+			"min_numbers": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The minimum number of numbers in generated passwords, between 0 and %d", passwordGeneratorMaxMinCount),
+				Validators: []validator.Int64{
+					int64validator.Between(0, passwordGeneratorMaxMinCount),
+				},
+			},
+			"min_special": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The minimum number of special characters in generated passwords, between 0 and %d", passwordGeneratorMaxMinCount),
+				Validators: []validator.Int64{
+					int64validator.Between(0, passwordGeneratorMaxMinCount),
+				},
+			},
+			"min_number_words": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The minimum number of words in generated passphrases, between %d and %d", passwordGeneratorMinNumberWords, passwordGeneratorMaxNumberWords),
+				Validators: []validator.Int64{
+					int64validator.Between(passwordGeneratorMinNumberWords, passwordGeneratorMaxNumberWords),
+				},
+			},
+			"capitalize": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Requires generated passphrases to capitalize the first letter of each word",
+				Default:     booldefault.StaticBool(false),
+			},
+			"include_number": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "Requires generated passphrases to include a number",
+				Default:     booldefault.StaticBool(false),
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// policy returns the policy described by the model.
+func (m *policyPasswordGeneratorResourceModel) policy() (bitwarden.Policy, error) {
+	policy := bitwarden.Policy{
+		Type:    bitwarden.PolicyPasswordGenerator,
+		Enabled: m.Enabled.ValueBool(),
+	}
+	err := policy.EncodeData(bitwarden.PasswordGeneratorPolicyData{
+		OverridePasswordType: m.OverridePasswordType.ValueString(),
+		MinLength:            m.MinLength.ValueInt64Pointer(),
+		UseUpper:             m.UseUpper.ValueBool(),
+		UseLower:             m.UseLower.ValueBool(),
+		UseNumbers:           m.UseNumbers.ValueBool(),
+		UseSpecial:           m.UseSpecial.ValueBool(),
+		MinNumbers:           m.MinNumbers.ValueInt64Pointer(),
+		MinSpecial:           m.MinSpecial.ValueInt64Pointer(),
+		MinNumberWords:       m.MinNumberWords.ValueInt64Pointer(),
+		Capitalize:           m.Capitalize.ValueBool(),
+		IncludeNumber:        m.IncludeNumber.ValueBool(),
+	})
+
+	return policy, err
+}
+
+// fromPolicy overwrites the model with the policy returned by Bitwarden.
+func (m *policyPasswordGeneratorResourceModel) fromPolicy(policy *bitwarden.Policy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data := bitwarden.PasswordGeneratorPolicyData{}
+	if err := policy.DecodeData(&data); err != nil {
+		diags.AddError("Unexpected Password Generator Policy Data", "Could not decode the settings of the password generator policy: "+err.Error())
+		return diags
+	}
+
+	m.ID = types.StringValue(policy.ID)
+	m.Enabled = types.BoolValue(policy.Enabled)
+	m.OverridePasswordType = types.StringNull()
+	if data.OverridePasswordType != "" {
+		m.OverridePasswordType = types.StringValue(data.OverridePasswordType)
+	}
+	m.MinLength = types.Int64PointerValue(data.MinLength)
+	m.UseUpper = types.BoolValue(data.UseUpper)
+	m.UseLower = types.BoolValue(data.UseLower)
+	m.UseNumbers = types.BoolValue(data.UseNumbers)
+	m.UseSpecial = types.BoolValue(data.UseSpecial)
+	m.MinNumbers = types.Int64PointerValue(data.MinNumbers)
+	m.MinSpecial = types.Int64PointerValue(data.MinSpecial)
+	m.MinNumberWords = types.Int64PointerValue(data.MinNumberWords)
+	m.Capitalize = types.BoolValue(data.Capitalize)
+	m.IncludeNumber = types.BoolValue(data.IncludeNumber)
+
+	return diags
+}
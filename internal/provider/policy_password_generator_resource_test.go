@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPolicyPasswordGeneratorResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPolicyPasswordGeneratorResourceConfig("password", 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bitwarden_policy_password_generator.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("bitwarden_policy_password_generator.test", "override_password_type", "password"),
+					resource.TestCheckResourceAttr("bitwarden_policy_password_generator.test", "min_length", "20"),
+					resource.TestCheckResourceAttr("bitwarden_policy_password_generator.test", "use_special", "true"),
+					resource.TestCheckResourceAttrSet("bitwarden_policy_password_generator.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "bitwarden_policy_password_generator.test",
+				ImportState:             true,
+				ImportStateId:           "password_generator",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			//Update and Read testing
+			{
+				Config: testAccPolicyPasswordGeneratorResourceConfig("passphrase", 24),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bitwarden_policy_password_generator.test", "override_password_type", "passphrase"),
+					resource.TestCheckResourceAttr("bitwarden_policy_password_generator.test", "min_length", "24"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccPolicyPasswordGeneratorResourceConfig(passwordType string, minLength int) string {
+	return fmt.Sprintf(`
+resource "bitwarden_policy_password_generator" "test" {
+  override_password_type = %q
+  min_length             = %d
+  use_special            = true
+  min_number_words       = 4
+}
+`, passwordType, minLength)
+}
@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &policyResource{}
+	_ resource.ResourceWithConfigure      = &policyResource{}
+	_ resource.ResourceWithValidateConfig = &policyResource{}
+	_ resource.ResourceWithImportState    = &policyResource{}
+)
+
+// NewPolicyResource is a helper function to simplify the provider implementation.
+func NewPolicyResource() resource.Resource {
+	return &policyResource{}
+}
+
+// policyResource is the resource implementation.
+type policyResource struct {
+	client *bitwarden.Client
+}
+
+type policyResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Type        types.String `tfsdk:"type"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Data        types.String `tfsdk:"data"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// policyFieldPaths maps the fields of the policy update request to their attribute, to report API validation errors.
+var policyFieldPaths = map[string]path.Path{
+	"enabled": path.Root("enabled"),
+	"data":    path.Root("data"),
+}
+
+// Metadata returns the resource type name.
+func (r *policyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+func (r *policyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(bitwarden.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitwarden.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &client
+}
+
+// Schema defines the schema for the resource.
+func (r *policyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Bitwarden policy resource manages an organization policy, such as requiring two-step login or setting the master password requirements. " +
+			"Every policy type exists once per organization, so declare each type at most once. Policies can't be deleted: destroying this resource disables the policy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The policy's unique identifier within the organization",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The policy type, one of " + strings.Join(policyTypeNames(), ", "),
+				Validators: []validator.String{
+					stringvalidator.OneOf(policyTypeNames()...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Required:    true,
+				Description: "Whether the policy is enforced",
+			},
+			"data": schema.StringAttribute{
+				Optional: true,
+				Description: "JSON encoded settings of the policy, use jsonencode. Only the master_password, password_generator, send_options, reset_password " +
+					"and maximum_vault_timeout policies have settings, which are validated against the fields and ranges Bitwarden accepts",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *policyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config policyResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.IsUnknown() || config.Type.IsNull() || config.Data.IsUnknown() {
+		return
+	}
+
+	if _, ok := policyTypes[config.Type.ValueString()]; !ok {
+		// Reported by the validator of type
+		return
+	}
+
+	if err := validatePolicyData(config.Type.ValueString(), config.Data.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("data"), "Invalid Policy Data", err.Error())
+	}
+}
+
+// Create enables or disables the policy and sets the initial Terraform state.
+func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan policyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every policy type exists once per organization, so the policy is configured rather than created
+	policy, err := (*r.client).UpdatePolicy(ctx, policyFromModel(plan))
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error creating policy",
+			"Could not update the "+plan.Type.ValueString()+" policy, unexpected error: ",
+			err,
+			policyFieldPaths,
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(policy.ID)
+	plan.Enabled = types.BoolValue(policy.Enabled)
+	plan.LastUpdated = types.StringValue(time.Now().UTC().Format(time.RFC850))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state policyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Type.ValueString()
+	spec, ok := policyTypes[name]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Unknown Policy Type", fmt.Sprintf("Expected one of %s, got: %q", strings.Join(policyTypeNames(), ", "), name))
+		return
+	}
+
+	// Get refreshed policy value from BitWarden
+	policy, err := (*r.client).GetPolicy(ctx, spec.policyType)
+	if bitwarden.IsNotFound(err) {
+		// The policy was never configured, so let Terraform configure it again
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Reading Bitwarden policy",
+			"Could not read the Bitwarden "+name+" policy: ",
+			err,
+			nil,
+		)
+		return
+	}
+
+	// Overwrite policy with refreshed state, keeping the configured data unless its settings changed
+	state.ID = types.StringValue(policy.ID)
+	state.Enabled = types.BoolValue(policy.Enabled)
+	if !policyDataEqual(name, state.Data.ValueString(), string(policy.Data)) {
+		// Store the settings in their canonical form rather than the server JSON, whose formatting varies
+		data, err := normalizePolicyData(name, policy.Data)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("data"), "Unexpected Policy Data", "Could not decode the settings of the "+name+" policy: "+err.Error())
+			return
+		}
+
+		state.Data = types.StringNull()
+		if data != "" {
+			state.Data = types.StringValue(data)
+		}
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan policyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing policy
+	policy, err := (*r.client).UpdatePolicy(ctx, policyFromModel(plan))
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Updating Bitwarden policy",
+			"Could not update the "+plan.Type.ValueString()+" policy, unexpected error: ",
+			err,
+			policyFieldPaths,
+		)
+		return
+	}
+
+	// Update resource state with updated items and timestamp
+	plan.ID = types.StringValue(policy.ID)
+	plan.Enabled = types.BoolValue(policy.Enabled)
+	plan.LastUpdated = types.StringValue(time.Now().UTC().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete disables the policy and removes the Terraform state on success.
+func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state policyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Policies can't be deleted, so disable it but keep its settings
+	policy := policyFromModel(state)
+	policy.Enabled = false
+	_, err := (*r.client).UpdatePolicy(ctx, policy)
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Deleting Bitwarden policy",
+			"Could not disable the "+state.Type.ValueString()+" policy, unexpected error: ",
+			err,
+			nil,
+		)
+		return
+	}
+}
+
+// ImportState imports a policy by its type, e.g. master_password.
+func (r *policyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, ok := policyTypes[req.ID]; !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected the policy type as import identifier, one of %s. Got: %q", strings.Join(policyTypeNames(), ", "), req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), req.ID)...)
+}
+
+// policyFromModel generates the API request body of a policy from its Terraform model.
+func policyFromModel(model policyResourceModel) bitwarden.Policy {
+	policy := bitwarden.Policy{
+		Type:    policyTypes[model.Type.ValueString()].policyType,
+		Enabled: model.Enabled.ValueBool(),
+	}
+	if data := model.Data.ValueString(); data != "" {
+		policy.Data = []byte(data)
+	}
+
+	return policy
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPolicyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPolicyResourceConfig(true, 14),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bitwarden_policy.test", "type", "master_password"),
+					resource.TestCheckResourceAttr("bitwarden_policy.test", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("bitwarden_policy.test", "id"),
+					resource.TestCheckResourceAttrSet("bitwarden_policy.test", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "bitwarden_policy.test",
+				ImportState:       true,
+				ImportStateId:     "master_password",
+				ImportStateVerify: true,
+				// The imported data holds every setting returned by Bitwarden, not only the configured ones
+				ImportStateVerifyIgnore: []string{"data", "last_updated"},
+			},
+			//Update and Read testing
+			{
+				Config: testAccPolicyResourceConfig(false, 16),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("bitwarden_policy.test", "enabled", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccPolicyResourceConfig(enabled bool, minLength int) string {
+	return fmt.Sprintf(`
+resource "bitwarden_policy" "test" {
+  type    = "master_password"
+  enabled = %t
+  data = jsonencode({
+    minComplexity = 3
+    minLength     = %d
+    requireUpper  = true
+  })
+}
+`, enabled, minLength)
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"terraform-provider-bitwarden/internal/bitwarden"
+)
+
+// typedPolicyModel is implemented by the pointer to the model of a typed policy resource.
+type typedPolicyModel[M any] interface {
+	*M
+	// policy returns the policy described by the model.
+	policy() (bitwarden.Policy, error)
+	// fromPolicy overwrites the model with the policy returned by Bitwarden.
+	fromPolicy(policy *bitwarden.Policy) diag.Diagnostics
+}
+
+// typedPolicyResource implements the resources managing a single policy type with typed attributes, such as
+// bitwarden_policy_master_password. M is the model of the resource and P its pointer type.
+type typedPolicyResource[M any, P typedPolicyModel[M]] struct {
+	client *bitwarden.Client
+
+	// name is the policy type name, which is also the import identifier, e.g. master_password.
+	name string
+	// label names the policy in diagnostics, e.g. master password.
+	label  string
+	schema schema.Schema
+	// fieldPaths maps the fields of the policy settings to their attribute, to report API validation errors.
+	fieldPaths map[string]path.Path
+}
+
+// Metadata returns the resource type name.
+func (r *typedPolicyResource[M, P]) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_" + r.name
+}
+
+func (r *typedPolicyResource[M, P]) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(bitwarden.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bitwarden.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &client
+}
+
+// Schema defines the schema for the resource.
+func (r *typedPolicyResource[M, P]) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = r.schema
+}
+
+// Create enables or disables the policy and sets the initial Terraform state.
+func (r *typedPolicyResource[M, P]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan M
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.update(ctx, &plan)
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error creating "+r.label+" policy",
+			"Could not update the "+r.label+" policy, unexpected error: ",
+			err,
+			r.fieldPaths,
+		)
+		return
+	}
+
+	r.setState(ctx, &resp.State, &resp.Diagnostics, plan, policy)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *typedPolicyResource[M, P]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state M
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed policy value from BitWarden
+	policy, err := (*r.client).GetPolicy(ctx, policyTypes[r.name].policyType)
+	if bitwarden.IsNotFound(err) {
+		// The policy was never configured, so let Terraform configure it again
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Reading Bitwarden "+r.label+" policy",
+			"Could not read the Bitwarden "+r.label+" policy: ",
+			err,
+			nil,
+		)
+		return
+	}
+
+	// Overwrite policy with refreshed state
+	resp.Diagnostics.Append(P(&state).fromPolicy(policy)...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *typedPolicyResource[M, P]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan M
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing policy
+	policy, err := r.update(ctx, &plan)
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Updating Bitwarden "+r.label+" policy",
+			"Could not update the "+r.label+" policy, unexpected error: ",
+			err,
+			r.fieldPaths,
+		)
+		return
+	}
+
+	r.setState(ctx, &resp.State, &resp.Diagnostics, plan, policy)
+}
+
+// Delete disables the policy and removes the Terraform state on success.
+func (r *typedPolicyResource[M, P]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state M
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Policies can't be deleted, so disable it but keep its settings
+	policy, err := P(&state).policy()
+	if err == nil {
+		policy.Enabled = false
+		_, err = (*r.client).UpdatePolicy(ctx, policy)
+	}
+	if err != nil {
+		addAPIError(
+			&resp.Diagnostics,
+			"Error Deleting Bitwarden "+r.label+" policy",
+			"Could not disable the "+r.label+" policy, unexpected error: ",
+			err,
+			nil,
+		)
+		return
+	}
+}
+
+// ImportState imports the policy by its type, like bitwarden_policy does. The ID is replaced by the one of the policy
+// on the following read.
+func (r *typedPolicyResource[M, P]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != r.name {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected the policy type as import identifier, %s. Got: %q", r.name, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// update sends the policy described by model to Bitwarden.
+func (r *typedPolicyResource[M, P]) update(ctx context.Context, model P) (*bitwarden.Policy, error) {
+	policy, err := model.policy()
+	if err != nil {
+		return nil, err
+	}
+
+	return (*r.client).UpdatePolicy(ctx, policy)
+}
+
+// setState stores the planned model, which Bitwarden accepted, with the ID of the policy. The settings returned by
+// Bitwarden are left to the next read, so that a value normalized by the API doesn't make the result inconsistent with
+// the plan.
+func (r *typedPolicyResource[M, P]) setState(ctx context.Context, state *tfsdk.State, diags *diag.Diagnostics, plan M, policy *bitwarden.Policy) {
+	diags.Append(state.Set(ctx, plan)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), policy.ID)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("last_updated"), time.Now().UTC().Format(time.RFC850))...)
+}
@@ -2,13 +2,19 @@ package provider
 
 import (
 	"context"
+	"math"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -20,6 +26,9 @@ var (
 	_ provider.Provider = &bitwardenProvider{}
 )
 
+// defaultTimeout applies to every resource operation that has no timeout configured in its timeouts block.
+const defaultTimeout = 5 * time.Minute
+
 // New is a helper function to simplify provider server and testing implementation.
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -43,6 +52,20 @@ type bitwardenProviderModel struct {
 	ClientSecret      types.String `tfsdk:"client_secret"`
 	APIUrl            types.String `tfsdk:"api_url"`
 	AuthenticationUrl types.String `tfsdk:"authentication_url"`
+	Region            types.String `tfsdk:"region"`
+	ServerUrl         types.String `tfsdk:"server_url"`
+	MaxRetries        types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait      types.Int64  `tfsdk:"retry_max_wait"`
+
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ClientCertPEM      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPEM       types.String `tfsdk:"client_key_pem"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
 }
 
 // Metadata returns the provider type name.
@@ -66,11 +89,94 @@ func (p *bitwardenProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Sensitive:           true,
 			},
 			"api_url": schema.StringAttribute{
-				MarkdownDescription: "The Bitwarden API URL, defaults to `https://api.bitwarden.com`, can also be configured as `BITWARDEN_API_URL`. See [docs](https://bitwarden.com/help/public-api/#endpoints) for more information",
+				MarkdownDescription: "The Bitwarden API URL, defaults to `https://api.bitwarden.com/public` or the URL derived from `region` or `server_url`, can also be configured as `BITWARDEN_API_URL`. See [docs](https://bitwarden.com/help/public-api/#endpoints) for more information",
 				Optional:            true,
+				Validators: []validator.String{
+					urlValidator{},
+				},
 			},
 			"authentication_url": schema.StringAttribute{
-				MarkdownDescription: "The Bitwarden Authentication URL, defaults to `https://identity.bitwarden.com/connect/token`, can also be configured as `BITWARDEN_AUTH_URL`. See [docs](https://bitwarden.com/help/public-api/#authentication-endpoints) for more information",
+				MarkdownDescription: "The Bitwarden Authentication URL, defaults to `https://identity.bitwarden.com/connect/token` or the URL derived from `region` or `server_url`, can also be configured as `BITWARDEN_AUTHENTICATION_URL`. See [docs](https://bitwarden.com/help/public-api/#authentication-endpoints) for more information",
+				Optional:            true,
+				Validators: []validator.String{
+					urlValidator{},
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The Bitwarden cloud region of your organisation, one of `us` or `eu`, from which `api_url` and `authentication_url` are derived. Defaults to `us`, can also be configured as `BITWARDEN_REGION`. Conflicts with `server_url`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("us", "eu"),
+					stringvalidator.ConflictsWith(path.MatchRoot("server_url")),
+				},
+			},
+			"server_url": schema.StringAttribute{
+				MarkdownDescription: "The base URL of a self-hosted Bitwarden server, e.g. `https://bitwarden.example.com`, from which `api_url` (`<server_url>/api/public`) and `authentication_url` (`<server_url>/identity/connect/token`) are derived. Can also be configured as `BITWARDEN_SERVER_URL`. Conflicts with `region`",
+				Optional:            true,
+				Validators: []validator.String{
+					urlValidator{},
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "The number of times a request failing with a transient error, such as `429 Too Many Requests` or `503 Service Unavailable`, is retried. Defaults to `3`, `0` disables retrying",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of seconds to wait between two attempts of a request, including waits requested by Bitwarden through the `Retry-After` header. Defaults to `30`",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "The maximum number of requests per second sent to the Bitwarden API, shared by all resources and data sources. Unlimited by default",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.1),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of requests in flight to the Bitwarden API at the same time, shared by all resources and data sources. Unlimited by default",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates to trust in addition to the system certificates, e.g. for a self-hosted Bitwarden behind an internal PKI. Conflicts with `ca_cert_file`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file with PEM encoded CA certificates to trust in addition to the system certificates. Conflicts with `ca_cert_pem`",
+				Optional:            true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate, for servers requiring mutual TLS. Requires `client_key_pem`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key_pem")),
+				},
+			},
+			"client_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of `client_cert_pem`",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert_pem")),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy to send all requests through, e.g. `http://proxy.example.com:3128`. Defaults to the `HTTPS_PROXY` and `NO_PROXY` environment variables",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Disables the verification of the TLS certificate of the Bitwarden server. **This makes the connection vulnerable to man-in-the-middle attacks, including the theft of the client_secret**, only use it for testing",
 				Optional:            true,
 			},
 		},
@@ -123,6 +229,60 @@ func (p *bitwardenProvider) Configure(ctx context.Context, req provider.Configur
 		)
 	}
 
+	if config.Region.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("region"),
+			"Unknown Bitwarden Region",
+			"The provider cannot create the Bitwarden API client as there is an unknown configuration value for the Bitwarden region. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the BITWARDEN_REGION environment variable.",
+		)
+	}
+
+	if config.ServerUrl.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("server_url"),
+			"Unknown Bitwarden Server URL",
+			"The provider cannot create the Bitwarden API client as there is an unknown configuration value for the Bitwarden server URL. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the BITWARDEN_SERVER_URL environment variable.",
+		)
+	}
+
+	if config.MaxRetries.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Unknown Bitwarden max_retries",
+			"The provider cannot create the Bitwarden API client as there is an unknown configuration value for the maximum number of retries. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+
+	if config.RetryMaxWait.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_wait"),
+			"Unknown Bitwarden retry_max_wait",
+			"The provider cannot create the Bitwarden API client as there is an unknown configuration value for the maximum wait between retries. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+
+	if config.RequestsPerSecond.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Unknown Bitwarden requests_per_second",
+			"The provider cannot create the Bitwarden API client as there is an unknown configuration value for the rate limit. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+
+	if config.MaxConcurrentRequests.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Unknown Bitwarden max_concurrent_requests",
+			"The provider cannot create the Bitwarden API client as there is an unknown configuration value for the maximum number of concurrent requests. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -133,6 +293,8 @@ func (p *bitwardenProvider) Configure(ctx context.Context, req provider.Configur
 	clientSecret := os.Getenv("BITWARDEN_CLIENT_SECRET")
 	apiUrl := os.Getenv("BITWARDEN_API_URL")
 	authUrl := os.Getenv("BITWARDEN_AUTHENTICATION_URL")
+	region := os.Getenv("BITWARDEN_REGION")
+	serverUrl := os.Getenv("BITWARDEN_SERVER_URL")
 
 	if !config.ClientID.IsNull() {
 		clientId = config.ClientID.ValueString()
@@ -144,16 +306,32 @@ func (p *bitwardenProvider) Configure(ctx context.Context, req provider.Configur
 
 	if !config.APIUrl.IsNull() {
 		apiUrl = config.APIUrl.ValueString()
-	} else if len(apiUrl) == 0 {
-		apiUrl = "https://api.bitwarden.com/public"
 	}
 
 	if !config.AuthenticationUrl.IsNull() {
 		authUrl = config.AuthenticationUrl.ValueString()
-	} else if len(authUrl) == 0 {
-		authUrl = "https://identity.bitwarden.com/connect/token"
 	}
 
+	if !config.Region.IsNull() {
+		region = config.Region.ValueString()
+	}
+
+	if !config.ServerUrl.IsNull() {
+		serverUrl = config.ServerUrl.ValueString()
+	}
+
+	// Derive the URLs which aren't set explicitly from the region or server URL
+	resolved, err := bitwarden.ResolveEndpoints(apiUrl, authUrl, region, serverUrl)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Bitwarden URL Configuration",
+			"The provider cannot create the Bitwarden API client as the configured URLs are invalid or inconsistent: "+err.Error(),
+		)
+		return
+	}
+	apiUrl = resolved.API
+	authUrl = resolved.Authentication
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 	if clientId == "" {
@@ -200,6 +378,56 @@ func (p *bitwardenProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
+	retry := bitwarden.DefaultRetryConfig
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		retry.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if !config.RetryMaxWait.IsNull() && !config.RetryMaxWait.IsUnknown() {
+		retry.MaxBackoff = time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+	}
+
+	opts := []bitwarden.Option{
+		bitwarden.WithRetry(retry),
+		bitwarden.WithUserAgent("terraform-provider-bitwarden/" + p.version),
+	}
+	if !config.RequestsPerSecond.IsNull() && !config.RequestsPerSecond.IsUnknown() {
+		requestsPerSecond := config.RequestsPerSecond.ValueFloat64()
+		opts = append(opts, bitwarden.WithRateLimit(requestsPerSecond, int(math.Ceil(requestsPerSecond))))
+	}
+	if !config.MaxConcurrentRequests.IsNull() && !config.MaxConcurrentRequests.IsUnknown() {
+		opts = append(opts, bitwarden.WithMaxConcurrentRequests(int(config.MaxConcurrentRequests.ValueInt64())))
+	}
+
+	transport := transportConfig{
+		CACertPEM:          config.CACertPEM.ValueString(),
+		CACertFile:         config.CACertFile.ValueString(),
+		ClientCertPEM:      config.ClientCertPEM.ValueString(),
+		ClientKeyPEM:       config.ClientKeyPEM.ValueString(),
+		ProxyURL:           config.ProxyURL.ValueString(),
+		InsecureSkipVerify: config.InsecureSkipVerify.ValueBool(),
+	}
+	if !transport.isDefault() {
+		httpTransport, err := newTransport(transport)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Bitwarden TLS or Proxy Configuration",
+				"The provider cannot create the Bitwarden API client as the TLS or proxy configuration is invalid: "+err.Error(),
+			)
+			return
+		}
+		opts = append(opts, bitwarden.WithTransport(httpTransport))
+	}
+
+	if transport.InsecureSkipVerify {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"Bitwarden TLS Certificate Verification Disabled",
+			"The provider does not verify the TLS certificate of the Bitwarden server. Anyone able to intercept the connection can "+
+				"impersonate Bitwarden and steal the client_secret and all data sent to or received from the API. "+
+				"Trust the certificate of the server with ca_cert_pem or ca_cert_file instead.",
+		)
+	}
+
 	ctx = tflog.SetField(ctx, "bitwarden_client_id", clientId)
 	ctx = tflog.SetField(ctx, "bitwarden_api_url", apiUrl)
 	ctx = tflog.SetField(ctx, "bitwarden_authentication_url", authUrl)
@@ -209,7 +437,7 @@ func (p *bitwardenProvider) Configure(ctx context.Context, req provider.Configur
 	tflog.Debug(ctx, "Creating Bitwarden API client")
 
 	// Create a new Bitwarden client using the configuration values
-	client, err := bitwarden.NewClient(ctx, clientId, clientSecret, apiUrl, authUrl)
+	client, err := bitwarden.NewClient(ctx, clientId, clientSecret, apiUrl, authUrl, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Bitwarden API Client",
@@ -228,7 +456,15 @@ func (p *bitwardenProvider) Configure(ctx context.Context, req provider.Configur
 
 // DataSources defines the data sources implemented in the provider.
 func (p *bitwardenProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		NewGroupDataSource,
+		NewMemberDataSource,
+		NewMembersDataSource,
+		NewGroupsDataSource,
+		NewCollectionsDataSource,
+		NewPoliciesDataSource,
+		NewEventsDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
@@ -236,5 +472,11 @@ func (p *bitwardenProvider) Resources(_ context.Context) []func() resource.Resou
 	return []func() resource.Resource{
 		NewGroupResource,
 		NewMemberResource,
+		NewCollectionResource,
+		NewGroupMembersResource,
+		NewGroupMemberResource,
+		NewPolicyResource,
+		NewPolicyMasterPasswordResource,
+		NewPolicyPasswordGeneratorResource,
 	}
 }
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// transportConfig holds the TLS and proxy settings of the provider.
+type transportConfig struct {
+	CACertPEM          string
+	CACertFile         string
+	ClientCertPEM      string
+	ClientKeyPEM       string
+	ProxyURL           string
+	InsecureSkipVerify bool
+}
+
+// isDefault reports whether the default transport can be used as is.
+func (c transportConfig) isDefault() bool {
+	return c == transportConfig{}
+}
+
+// newTransport returns a copy of http.DefaultTransport with the TLS and proxy settings applied, so it keeps the
+// default timeouts and connection pooling. Without a proxy_url, the proxy is still taken from the environment.
+func newTransport(config transportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert // set by net/http
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	caCertPEM := []byte(config.CACertPEM)
+	if config.CACertFile != "" {
+		var err error
+		caCertPEM, err = os.ReadFile(config.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA certificate file: %w", err)
+		}
+	}
+
+	if len(caCertPEM) != 0 {
+		// Trust the system certificates as well, the identity endpoint may be served by another CA
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caCertPEM) {
+			return nil, errors.New("unable to parse CA certificate: no PEM encoded certificate found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCertPEM != "" || config.ClientKeyPEM != "" {
+		certificate, err := tls.X509KeyPair([]byte(config.ClientCertPEM), []byte(config.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse proxy URL: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return transport, nil
+}
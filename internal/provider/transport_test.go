@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewTransportTrustsCACertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caCertPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	// Without the CA certificate the server isn't trusted
+	transport, err := newTransport(transportConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (&http.Client{Transport: transport}).Get(server.URL); err == nil {
+		t.Fatal("expected the certificate of the test server to be rejected")
+	}
+
+	transport, err = newTransport(transportConfig{CACertPEM: string(caCertPEM)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := (&http.Client{Transport: transport}).Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+}
+
+func TestNewTransportRejectsInvalidSettings(t *testing.T) {
+	for name, config := range map[string]transportConfig{
+		"ca certificate":      {CACertPEM: "not a certificate"},
+		"ca certificate file": {CACertFile: "does-not-exist.pem"},
+		"client certificate":  {ClientCertPEM: "not a certificate", ClientKeyPEM: "not a key"},
+		"proxy url":           {ProxyURL: "://proxy"},
+	} {
+		if _, err := newTransport(config); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}